@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // AccountAvailability returns the resources availability in a region to an account.
@@ -21,6 +22,12 @@ func (c *Client) ListAccountAvailabilities(ctx context.Context, opts *ListOption
 	return getPaginatedResults[AccountAvailability](ctx, c, "account/availability", opts)
 }
 
+// IterAccountAvailabilities returns an iterator over the results of ListAccountAvailabilities,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterAccountAvailabilities(ctx context.Context, opts *ListOptions) iter.Seq2[AccountAvailability, error] {
+	return getPaginatedIter[AccountAvailability](ctx, c, "account/availability", opts)
+}
+
 // GetAccountAvailability gets the resources availability in a region to the customer.
 func (c *Client) GetAccountAvailability(ctx context.Context, regionID string) (*AccountAvailability, error) {
 	b := formatAPIPath("account/availability/%s", regionID)
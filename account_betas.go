@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -57,6 +58,12 @@ func (c *Client) ListAccountBetaPrograms(ctx context.Context, opts *ListOptions)
 	return getPaginatedResults[AccountBetaProgram](ctx, c, "/account/betas", opts)
 }
 
+// IterAccountBetaPrograms returns an iterator over the results of ListAccountBetaPrograms,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterAccountBetaPrograms(ctx context.Context, opts *ListOptions) iter.Seq2[AccountBetaProgram, error] {
+	return getPaginatedIter[AccountBetaProgram](ctx, c, "/account/betas", opts)
+}
+
 // GetAccountBetaProgram gets the details of a beta program an account is enrolled in.
 func (c *Client) GetAccountBetaProgram(ctx context.Context, betaID string) (*AccountBetaProgram, error) {
 	e := formatAPIPath("/account/betas/%s", betaID)
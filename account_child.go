@@ -2,6 +2,11 @@ package linodego
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+	"time"
 )
 
 // ChildAccount represents an account under the current account.
@@ -24,6 +29,17 @@ func (c *Client) ListChildAccounts(ctx context.Context, opts *ListOptions) ([]Ch
 	)
 }
 
+// IterChildAccounts returns an iterator over the results of ListChildAccounts,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterChildAccounts(ctx context.Context, opts *ListOptions) iter.Seq2[ChildAccount, error] {
+	return getPaginatedIter[ChildAccount](
+		ctx,
+		c,
+		"account/child-accounts",
+		opts,
+	)
+}
+
 // GetChildAccount gets a single child accounts under the current account.
 // NOTE: Parent/Child related features may not be generally available.
 func (c *Client) GetChildAccount(ctx context.Context, euuid string) (*ChildAccount, error) {
@@ -45,3 +61,172 @@ func (c *Client) CreateChildAccountToken(ctx context.Context, euuid string) (*Ch
 		formatAPIPath("account/child-accounts/%s/token", euuid),
 	)
 }
+
+// childAccountTokenRefreshWindow is how long before a child account
+// token expires that a new token is created.
+const childAccountTokenRefreshWindow = 2 * time.Minute
+
+// childAccountCredentialProvider provides tokens for a child account,
+// creating a new token using the parent client before the current token expires.
+type childAccountCredentialProvider struct {
+	parent *Client
+	euuid  string
+
+	mu          sync.Mutex
+	credentials *Credentials
+}
+
+var _ CredentialInvalidator = (*childAccountCredentialProvider)(nil)
+
+func (p *childAccountCredentialProvider) Credentials(ctx context.Context) (*Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.credentials != nil &&
+		(p.credentials.Expiry.IsZero() || time.Until(p.credentials.Expiry) > childAccountTokenRefreshWindow) {
+		return p.credentials, nil
+	}
+
+	token, err := p.parent.CreateChildAccountToken(ctx, p.euuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token for child account %s: %w", p.euuid, err)
+	}
+
+	p.credentials = &Credentials{Token: token.Token}
+	if token.Expiry != nil {
+		p.credentials.Expiry = *token.Expiry
+	}
+
+	return p.credentials, nil
+}
+
+func (p *childAccountCredentialProvider) InvalidateCredentials() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.credentials = nil
+}
+
+// ForChildAccount returns a client that makes requests under the child account with the given EUUID.
+// The client is authenticated with short-lived tokens created using CreateChildAccountToken,
+// and a new token is created before the current token expires.
+// The returned client is derived from c, as with Client.With, but has its own response cache.
+// NOTE: Parent/Child related features may not be generally available.
+func (c *Client) ForChildAccount(ctx context.Context, euuid string) (*Client, error) {
+	parent, err := c.With()
+	if err != nil {
+		return nil, err
+	}
+
+	provider := &childAccountCredentialProvider{
+		parent: parent,
+		euuid:  euuid,
+	}
+
+	// Create the first token so an inaccessible child account is reported immediately
+	if _, err := provider.Credentials(ctx); err != nil {
+		return nil, err
+	}
+
+	child, err := c.With(WithIsolatedCache())
+	if err != nil {
+		return nil, err
+	}
+
+	child.header.Del("Authorization")
+	child.SetCredentialProvider(provider)
+
+	return child, nil
+}
+
+// ForEachChildAccountOptions configures ForEachChildAccount.
+type ForEachChildAccountOptions struct {
+	// ListOptions are used to list the child accounts, e.g. to filter them.
+	ListOptions *ListOptions
+
+	// Concurrency is the maximum number of child accounts processed at once.
+	// Defaults to 1.
+	Concurrency int
+
+	// ContinueOnError processes the remaining child accounts when processing a child account fails.
+	// The errors are joined and returned once all child accounts have been processed.
+	ContinueOnError bool
+}
+
+// ForEachChildAccount calls fn for each child account of the current account, with a client
+// scoped to the child account as returned by ForChildAccount. Child accounts are listed lazily,
+// and processed concurrently up to the configured concurrency.
+//
+// Unless ContinueOnError is set, the first error stops any further child accounts from being
+// processed, cancels the context passed to fn, and is returned.
+// NOTE: Parent/Child related features may not be generally available.
+func (c *Client) ForEachChildAccount(
+	ctx context.Context,
+	opts *ForEachChildAccountOptions,
+	fn func(ctx context.Context, account ChildAccount, client *Client) error,
+) error {
+	if opts == nil {
+		opts = &ForEachChildAccountOptions{}
+	}
+
+	concurrency := max(opts.Concurrency, 1)
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var (
+		wg       sync.WaitGroup
+		errsLock sync.Mutex
+		errs     []error
+	)
+
+	semaphore := make(chan struct{}, concurrency)
+
+	handleErr := func(err error) {
+		if !opts.ContinueOnError {
+			cancel(err)
+			return
+		}
+
+		errsLock.Lock()
+		errs = append(errs, err)
+		errsLock.Unlock()
+	}
+
+	for account, err := range c.IterChildAccounts(ctx, opts.ListOptions) {
+		if err != nil {
+			handleErr(err)
+			break
+		}
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Go(func() {
+			defer func() { <-semaphore }()
+
+			child, err := c.ForChildAccount(ctx, account.EUUID)
+			if err == nil {
+				err = fn(ctx, account, child)
+			}
+
+			if err != nil {
+				handleErr(fmt.Errorf("child account %s: %w", account.EUUID, err))
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if err := context.Cause(ctx); err != nil {
+		return err
+	}
+
+	return errors.Join(errs...)
+}
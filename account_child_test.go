@@ -0,0 +1,213 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testChildAccountServer struct {
+	*httptest.Server
+
+	tokenLifetime time.Duration
+	tokensCreated atomic.Int32
+
+	mu          sync.Mutex
+	active      int
+	maxActive   int
+	authHeaders map[string][]string
+}
+
+func newTestChildAccountServer(t *testing.T, euuids []string, tokenLifetime time.Duration) *testChildAccountServer {
+	t.Helper()
+
+	s := &testChildAccountServer{
+		tokenLifetime: tokenLifetime,
+		authHeaders:   make(map[string][]string),
+	}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /v4/account/child-accounts", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer parent", r.Header.Get("Authorization"))
+
+		accounts := make([]map[string]any, len(euuids))
+		for i, euuid := range euuids {
+			accounts[i] = map[string]any{"euuid": euuid}
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": accounts, "page": 1, "pages": 1, "results": len(euuids)})
+	})
+
+	mux.HandleFunc("POST /v4/account/child-accounts/{euuid}/token", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer parent", r.Header.Get("Authorization"))
+
+		if r.PathValue("euuid") == "inaccessible" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Unauthorized"}]}`))
+
+			return
+		}
+
+		n := s.tokensCreated.Add(1)
+		expiry := time.Now().UTC().Add(s.tokenLifetime).Format("2006-01-02T15:04:05")
+
+		_, _ = fmt.Fprintf(w, `{"token": "%s-%d", "expiry": %q}`, r.PathValue("euuid"), n, expiry)
+	})
+
+	mux.HandleFunc("GET /v4/account", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.active++
+		s.maxActive = max(s.maxActive, s.active)
+
+		auth := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		euuid, _, _ := strings.Cut(auth, "-")
+		s.authHeaders[euuid] = append(s.authHeaders[euuid], auth)
+		s.mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		s.mu.Lock()
+		s.active--
+		s.mu.Unlock()
+
+		_, _ = fmt.Fprintf(w, `{"euuid": %q}`, euuid)
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *testChildAccountServer) client(t *testing.T) *Client {
+	t.Helper()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(s.URL).SetToken("parent")
+
+	return &client
+}
+
+func TestClient_ForChildAccount(t *testing.T) {
+	ctx := context.Background()
+
+	server := newTestChildAccountServer(t, nil, time.Hour)
+	client := server.client(t)
+
+	child, err := client.ForChildAccount(ctx, "child")
+	require.NoError(t, err)
+
+	for range 3 {
+		account, err := child.GetAccount(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "child", account.EUUID)
+	}
+
+	// The token is reused until it nears expiry
+	require.Equal(t, int32(1), server.tokensCreated.Load())
+	require.Equal(t, []string{"child-1", "child-1", "child-1"}, server.authHeaders["child"])
+
+	// The parent client is unaffected
+	require.Equal(t, "Bearer parent", client.header.Get("Authorization"))
+
+	_, err = client.ForChildAccount(ctx, "inaccessible")
+	require.True(t, ErrHasStatus(err, http.StatusForbidden))
+}
+
+func TestClient_ForChildAccount_RefreshesToken(t *testing.T) {
+	ctx := context.Background()
+
+	// Tokens expire within the refresh window, so a new token is created for each request
+	server := newTestChildAccountServer(t, nil, time.Minute)
+	client := server.client(t)
+
+	child, err := client.ForChildAccount(ctx, "child")
+	require.NoError(t, err)
+
+	_, err = child.GetAccount(ctx)
+	require.NoError(t, err)
+
+	_, err = child.GetAccount(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, int32(3), server.tokensCreated.Load())
+	require.Equal(t, []string{"child-2", "child-3"}, server.authHeaders["child"])
+}
+
+func TestClient_ForEachChildAccount(t *testing.T) {
+	ctx := context.Background()
+
+	euuids := []string{"a", "b", "c", "d", "e", "f"}
+
+	server := newTestChildAccountServer(t, euuids, time.Hour)
+	client := server.client(t)
+
+	var (
+		mu      sync.Mutex
+		visited []string
+	)
+
+	err := client.ForEachChildAccount(ctx, &ForEachChildAccountOptions{Concurrency: 2},
+		func(ctx context.Context, account ChildAccount, child *Client) error {
+			result, err := child.GetAccount(ctx)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			visited = append(visited, result.EUUID)
+			mu.Unlock()
+
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.ElementsMatch(t, euuids, visited)
+	require.Equal(t, 2, server.maxActive)
+}
+
+func TestClient_ForEachChildAccount_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	server := newTestChildAccountServer(t, []string{"a", "inaccessible", "b"}, time.Hour)
+	client := server.client(t)
+
+	errFailed := errors.New("failed")
+
+	var calls atomic.Int32
+
+	fn := func(ctx context.Context, account ChildAccount, child *Client) error {
+		calls.Add(1)
+
+		if account.EUUID == "b" {
+			return errFailed
+		}
+
+		return nil
+	}
+
+	err := client.ForEachChildAccount(ctx, &ForEachChildAccountOptions{ContinueOnError: true}, fn)
+	require.ErrorIs(t, err, errFailed)
+	require.True(t, ErrHasStatus(err, http.StatusForbidden))
+	require.ErrorContains(t, err, "child account inaccessible")
+	require.Equal(t, int32(2), calls.Load())
+
+	calls.Store(0)
+
+	// Processing stops at the first error by default
+	err = client.ForEachChildAccount(ctx, nil, fn)
+	require.True(t, ErrHasStatus(err, http.StatusForbidden))
+	require.NotErrorIs(t, err, errFailed)
+	require.Equal(t, int32(1), calls.Load())
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/duration"
@@ -329,6 +330,12 @@ func (c *Client) ListEvents(ctx context.Context, opts *ListOptions) ([]Event, er
 	return getPaginatedResults[Event](ctx, c, "account/events", opts)
 }
 
+// IterEvents returns an iterator over the results of ListEvents,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterEvents(ctx context.Context, opts *ListOptions) iter.Seq2[Event, error] {
+	return getPaginatedIter[Event](ctx, c, "account/events", opts)
+}
+
 // GetEvent gets the Event with the Event ID
 func (c *Client) GetEvent(ctx context.Context, eventID int) (*Event, error) {
 	e := formatAPIPath("account/events/%d", eventID)
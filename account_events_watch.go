@@ -0,0 +1,534 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultEventWatchMaxLookback is the default maximum age of the events delivered by an event watch.
+const DefaultEventWatchMaxLookback = 24 * time.Hour
+
+// EventCursor is the position of an event watch in the account's event stream.
+// It can be persisted (e.g. as JSON) and passed to WatchEvents to resume a watch
+// without missing or repeating events.
+type EventCursor struct {
+	// LastEventID is the ID of the most recent event seen by the watch.
+	LastEventID int `json:"last_event_id"`
+
+	// Pending contains the last seen status of events that were in progress,
+	// so their status transitions can be delivered.
+	Pending map[int]EventStatus `json:"pending,omitempty"`
+}
+
+func (c EventCursor) clone() EventCursor {
+	c.Pending = maps.Clone(c.Pending)
+	return c
+}
+
+// EventWatchOptions configures an event watch created using WatchEvents.
+// Empty filters match all events.
+type EventWatchOptions struct {
+	// EntityTypes restricts the watch to events for the given entity types.
+	EntityTypes []EntityType
+
+	// EntityIDs restricts the watch to events for the given entity IDs.
+	EntityIDs []any
+
+	// Actions restricts the watch to events with the given actions.
+	Actions []EventAction
+
+	// Statuses restricts deliveries to events with the given statuses,
+	// e.g. only EventFinished and EventFailed.
+	Statuses []EventStatus
+
+	// Cursor resumes the watch from a previous position.
+	// If nil, the watch only delivers events created after it is started.
+	Cursor *EventCursor
+
+	// MaxLookback is the maximum age of the events delivered by the watch.
+	// Older events are skipped, and pending events that become older are no longer tracked,
+	// so resuming from an old cursor does not page through the account's whole event history.
+	// Defaults to DefaultEventWatchMaxLookback.
+	MaxLookback time.Duration
+}
+
+// EventDelivery is an event delivered by an event watch.
+type EventDelivery struct {
+	Event Event
+
+	// Cursor is the position of the watch after this event.
+	// Persist it once the event has been handled to resume the watch later.
+	Cursor EventCursor
+}
+
+// EventWatch is a subscription to the account's event stream created using WatchEvents.
+type EventWatch struct {
+	options EventWatchOptions
+	hub     *eventHub
+
+	entityIDs map[string]bool
+
+	// cursor is the position of the watch, guarded by hub.mu
+	cursor EventCursor
+
+	events    chan EventDelivery
+	done      chan struct{}
+	closeOnce sync.Once
+
+	mu     sync.Mutex
+	queue  []EventDelivery
+	notify chan struct{}
+	err    error
+}
+
+// WatchEvents watches the account's event stream for new events and status transitions
+// matching the given options. Each status an event is seen in (e.g. started, then finished)
+// is delivered separately.
+//
+// All watches of a client share a single poll loop, which polls the API at the client's poll delay
+// (see SetPollDelay). The watch ends when ctx is canceled, Close is called or polling fails
+// (see EventWatch.Err), closing its Events channel.
+//
+// The poll loop uses a copy of the client taken when it is started by the first watch,
+// so changes to the client made afterwards (e.g. using SetToken or SetBaseURL)
+// only apply to watches once all running watches have ended.
+//
+//	watch, err := client.WatchEvents(ctx, linodego.EventWatchOptions{
+//		EntityTypes: []linodego.EntityType{linodego.EntityLinode},
+//		Statuses:    []linodego.EventStatus{linodego.EventFinished, linodego.EventFailed},
+//	})
+//	for delivery := range watch.Events() {
+//		handle(delivery.Event)
+//		saveCursor(delivery.Cursor)
+//	}
+//	if err := watch.Err(); err != nil {
+//		return err
+//	}
+func (c *Client) WatchEvents(ctx context.Context, opts EventWatchOptions) (*EventWatch, error) {
+	if opts.MaxLookback <= 0 {
+		opts.MaxLookback = DefaultEventWatchMaxLookback
+	}
+
+	watch := &EventWatch{
+		options:   opts,
+		hub:       c.eventHub,
+		entityIDs: make(map[string]bool, len(opts.EntityIDs)),
+		events:    make(chan EventDelivery),
+		done:      make(chan struct{}),
+		notify:    make(chan struct{}, 1),
+	}
+
+	// Clients that were not created using NewClient do not have a shared event hub
+	if watch.hub == nil {
+		watch.hub = newEventHub()
+	}
+
+	for _, id := range opts.EntityIDs {
+		watch.entityIDs[eventEntityIDString(id)] = true
+	}
+
+	if opts.Cursor != nil {
+		watch.cursor = opts.Cursor.clone()
+	} else {
+		lastEventID, err := c.lastEventID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		watch.cursor.LastEventID = lastEventID
+	}
+
+	watch.hub.subscribe(c, watch)
+
+	go watch.run(ctx)
+
+	return watch, nil
+}
+
+// Events returns the channel events are delivered on.
+// It is closed when the watch ends.
+func (w *EventWatch) Events() <-chan EventDelivery {
+	return w.events
+}
+
+// Close ends the watch.
+func (w *EventWatch) Close() {
+	w.closeOnce.Do(func() {
+		close(w.done)
+	})
+}
+
+// Err returns the error that ended the watch, if any.
+// Watches end with an error when polling the event stream fails with an error that is not transient,
+// e.g. because the token of the client was revoked. Transient errors are logged and polled again.
+func (w *EventWatch) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.err
+}
+
+// fail ends the watch with the given error.
+func (w *EventWatch) fail(err error) {
+	w.mu.Lock()
+	if w.err == nil {
+		w.err = fmt.Errorf("failed to poll events: %w", err)
+	}
+	w.mu.Unlock()
+
+	w.Close()
+}
+
+// run delivers queued events until the watch ends.
+func (w *EventWatch) run(ctx context.Context) {
+	defer close(w.events)
+	defer w.hub.unsubscribe(w)
+
+	for {
+		w.mu.Lock()
+
+		var (
+			delivery EventDelivery
+			ok       bool
+		)
+
+		if len(w.queue) > 0 {
+			delivery, ok = w.queue[0], true
+			w.queue = w.queue[1:]
+		}
+
+		w.mu.Unlock()
+
+		if !ok {
+			select {
+			case <-w.notify:
+				continue
+			case <-ctx.Done():
+				return
+			case <-w.done:
+				return
+			}
+		}
+
+		select {
+		case w.events <- delivery:
+		case <-ctx.Done():
+			return
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// floor returns the lowest ID of the new events the watch needs to see.
+// Pending events are checked separately. The caller must hold hub.mu.
+func (w *EventWatch) floor() int {
+	return w.cursor.LastEventID + 1
+}
+
+// process updates the cursor of the watch using the given events, sorted by ID,
+// and queues the events to deliver. Pending events whose IDs were checked but
+// are not in events are no longer tracked. The caller must hold hub.mu.
+func (w *EventWatch) process(events []Event, checked map[int]bool, now time.Time) {
+	var deliveries []EventDelivery
+
+	since := now.Add(-w.options.MaxLookback)
+
+	// Pending events that were checked but not listed have expired
+	missing := maps.Clone(checked)
+	for _, event := range events {
+		delete(missing, event.ID)
+	}
+
+	for id := range missing {
+		delete(w.cursor.Pending, id)
+	}
+
+	for _, event := range events {
+		isNew := event.ID > w.cursor.LastEventID
+		previous, isPending := w.cursor.Pending[event.ID]
+
+		if isNew {
+			w.cursor.LastEventID = event.ID
+		}
+
+		if event.Created != nil && event.Created.Before(since) {
+			delete(w.cursor.Pending, event.ID)
+			continue
+		}
+
+		if !w.matches(event) || (!isNew && (!isPending || previous == event.Status)) {
+			continue
+		}
+
+		if isEventTerminal(event.Status) {
+			delete(w.cursor.Pending, event.ID)
+		} else {
+			if w.cursor.Pending == nil {
+				w.cursor.Pending = make(map[int]EventStatus)
+			}
+
+			w.cursor.Pending[event.ID] = event.Status
+		}
+
+		if len(w.options.Statuses) == 0 || slices.Contains(w.options.Statuses, event.Status) {
+			deliveries = append(deliveries, EventDelivery{Event: event, Cursor: w.cursor.clone()})
+		}
+	}
+
+	if len(deliveries) == 0 {
+		return
+	}
+
+	w.mu.Lock()
+	w.queue = append(w.queue, deliveries...)
+	w.mu.Unlock()
+
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+// matches returns whether the event matches the entity and action filters of the watch.
+func (w *EventWatch) matches(event Event) bool {
+	if len(w.options.Actions) > 0 && !slices.Contains(w.options.Actions, event.Action) {
+		return false
+	}
+
+	if len(w.options.EntityTypes) == 0 && len(w.entityIDs) == 0 {
+		return true
+	}
+
+	if event.Entity == nil {
+		return false
+	}
+
+	if len(w.options.EntityTypes) > 0 && !slices.Contains(w.options.EntityTypes, event.Entity.Type) {
+		return false
+	}
+
+	return len(w.entityIDs) == 0 || w.entityIDs[eventEntityIDString(event.Entity.ID)]
+}
+
+// eventHub runs the poll loop shared by the event watches of a client.
+type eventHub struct {
+	mu      sync.Mutex
+	watches map[*EventWatch]struct{}
+	stop    context.CancelFunc
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{watches: make(map[*EventWatch]struct{})}
+}
+
+// subscribe adds a watch to the hub, starting the poll loop using the given client if needed.
+func (h *eventHub) subscribe(client *Client, watch *EventWatch) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.watches[watch] = struct{}{}
+
+	if h.stop == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		h.stop = cancel
+
+		go h.run(ctx, *client)
+	}
+}
+
+// unsubscribe removes a watch from the hub, stopping the poll loop if no watches are left.
+func (h *eventHub) unsubscribe(watch *EventWatch) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.watches, watch)
+
+	if len(h.watches) == 0 && h.stop != nil {
+		h.stop()
+		h.stop = nil
+	}
+}
+
+func (h *eventHub) run(ctx context.Context, client Client) {
+	ticker := newTicker(&client)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			h.poll(ctx, &client)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// poll lists the new events needed by the watches, and the current state of their pending events,
+// then passes them to each watch.
+func (h *eventHub) poll(ctx context.Context, client *Client) {
+	h.mu.Lock()
+
+	var (
+		floor    int
+		lookback time.Duration
+		pending  = make(map[int]bool)
+	)
+
+	for watch := range h.watches {
+		if watchFloor := watch.floor(); floor == 0 || watchFloor < floor {
+			floor = watchFloor
+		}
+
+		lookback = max(lookback, watch.options.MaxLookback)
+
+		for id := range watch.cursor.Pending {
+			pending[id] = true
+		}
+	}
+
+	h.mu.Unlock()
+
+	if floor == 0 {
+		return
+	}
+
+	now := time.Now()
+
+	filter := Filter{
+		Order:   Ascending,
+		OrderBy: "created",
+	}
+	filter.AddField(Gte, "id", floor)
+	filter.AddField(Gte, "created", now.Add(-lookback).UTC().Format("2006-01-02T15:04:05"))
+
+	events, err := h.listEvents(ctx, client, &filter)
+	if err != nil {
+		h.fail(ctx, err)
+		return
+	}
+
+	// Pending events are checked by ID, so they do not require listing every event since the oldest one
+	for ids := range slices.Chunk(slices.Sorted(maps.Keys(pending)), maxEventFilterTargets) {
+		nodes := make([]FilterNode, len(ids))
+		for i, id := range ids {
+			nodes[i] = FieldEq("id", id)
+		}
+
+		pendingEvents, err := h.listEvents(ctx, client, Or(Ascending, "created", nodes...))
+		if err != nil {
+			h.fail(ctx, err)
+			return
+		}
+
+		events = append(events, pendingEvents...)
+	}
+
+	slices.SortFunc(events, func(a, b Event) int {
+		return a.ID - b.ID
+	})
+
+	events = slices.CompactFunc(events, func(a, b Event) bool {
+		return a.ID == b.ID
+	})
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// The loop may have been stopped while listing events
+	if ctx.Err() != nil {
+		return
+	}
+
+	for watch := range h.watches {
+		// Watches added while listing events may need older events,
+		// which are listed on the next poll
+		if watch.floor() >= floor {
+			watch.process(events, pending, now)
+		}
+	}
+}
+
+// fail ends the watches with the given poll error, unless the error is transient
+// (e.g. a 5xx response or a timeout) and the events can be polled again.
+func (h *eventHub) fail(ctx context.Context, err error) {
+	if ctx.Err() != nil || isTransientError(err) {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for watch := range h.watches {
+		watch.fail(err)
+	}
+}
+
+// listEvents lists the events matching the given filter, logging any error.
+func (h *eventHub) listEvents(ctx context.Context, client *Client, filter *Filter) ([]Event, error) {
+	filterStr, err := filter.MarshalJSON()
+	if err != nil {
+		log.Printf("[WARN] Failed to create event watch filter: %s", err)
+		return nil, err
+	}
+
+	events, err := client.ListEvents(ctx, &ListOptions{Filter: string(filterStr)})
+	if err != nil && ctx.Err() == nil {
+		log.Printf("[WARN] Failed to poll events: %s", err)
+	}
+
+	return events, err
+}
+
+// lastEventID returns the ID of the most recent event of the account, or 0 if there are none.
+func (c *Client) lastEventID(ctx context.Context) (int, error) {
+	filter := Filter{
+		Order:   Descending,
+		OrderBy: "created",
+	}
+
+	filterStr, err := filter.MarshalJSON()
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := c.ListEvents(ctx, &ListOptions{Filter: string(filterStr), PageOptions: &PageOptions{Page: 1}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	lastEventID := 0
+	for _, event := range events {
+		lastEventID = max(lastEventID, event.ID)
+	}
+
+	return lastEventID, nil
+}
+
+// isEventTerminal returns whether an event with the given status will not change status again.
+func isEventTerminal(status EventStatus) bool {
+	switch status {
+	case EventFinished, EventFailed, EventNotification, EventCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// eventEntityIDString normalizes an entity ID, which may be decoded as a float64.
+func eventEntityIDString(id any) string {
+	switch id := id.(type) {
+	case float64:
+		return strconv.FormatFloat(id, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(id)
+	default:
+		return fmt.Sprintf("%v", id)
+	}
+}
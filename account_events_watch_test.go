@@ -0,0 +1,288 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEventStream struct {
+	mu     sync.Mutex
+	events []map[string]any
+	polls  int
+
+	// status is the status of the error returned for each request, if set
+	status int
+
+	// maxIDs is the largest number of event IDs requested by a single query
+	maxIDs int
+}
+
+func (s *fakeEventStream) add(id int, action EventAction, status EventStatus, entityID int) {
+	s.addCreated(id, action, status, entityID, time.Now())
+}
+
+func (s *fakeEventStream) addCreated(id int, action EventAction, status EventStatus, entityID int, created time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, map[string]any{
+		"id":      id,
+		"action":  action,
+		"status":  status,
+		"created": created.UTC().Format("2006-01-02T15:04:05"),
+		"entity":  map[string]any{"id": entityID, "type": EntityLinode},
+	})
+}
+
+func (s *fakeEventStream) setStatus(id int, status EventStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, event := range s.events {
+		if event["id"] == id {
+			event["status"] = status
+		}
+	}
+}
+
+func (s *fakeEventStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var filter struct {
+		ID struct {
+			Gte int `json:"+gte"`
+		} `json:"id"`
+		Created struct {
+			Gte string `json:"+gte"`
+		} `json:"created"`
+		Or []map[string]int `json:"+or"`
+	}
+
+	if header := r.Header.Get("X-Filter"); header != "" {
+		if err := json.Unmarshal([]byte(header), &filter); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	s.mu.Lock()
+
+	if s.status != 0 {
+		s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(s.status)
+		_, _ = w.Write([]byte(`{"errors": [{"reason": "Invalid Token"}]}`))
+
+		return
+	}
+
+	if filter.ID.Gte > 0 {
+		s.polls++
+	}
+
+	s.maxIDs = max(s.maxIDs, len(filter.Or))
+
+	data := make([]map[string]any, 0, len(s.events))
+
+	for _, event := range s.events {
+		if filter.Or != nil {
+			if slices.ContainsFunc(filter.Or, func(node map[string]int) bool {
+				return node["id"] == event["id"]
+			}) {
+				data = append(data, event)
+			}
+
+			continue
+		}
+
+		if event["id"].(int) >= filter.ID.Gte && event["created"].(string) >= filter.Created.Gte {
+			data = append(data, event)
+		}
+	}
+
+	body, _ := json.Marshal(map[string]any{"data": data, "page": 1, "pages": 1, "results": len(data)})
+
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+func receiveEvent(t *testing.T, watch *EventWatch) EventDelivery {
+	t.Helper()
+
+	select {
+	case delivery, ok := <-watch.Events():
+		require.True(t, ok, "watch ended")
+		return delivery
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for event")
+		return EventDelivery{}
+	}
+}
+
+func TestClient_WatchEvents(t *testing.T) {
+	stream := &fakeEventStream{}
+	stream.add(1, ActionLinodeCreate, EventFinished, 100)
+
+	server := httptest.NewServer(stream)
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(10 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	all, err := client.WatchEvents(ctx, EventWatchOptions{})
+	require.NoError(t, err)
+
+	booted, err := client.WatchEvents(ctx, EventWatchOptions{
+		EntityTypes: []EntityType{EntityLinode},
+		EntityIDs:   []any{100},
+		Actions:     []EventAction{ActionLinodeBoot},
+		Statuses:    []EventStatus{EventFinished},
+	})
+	require.NoError(t, err)
+
+	stream.add(2, ActionLinodeBoot, EventStarted, 100)
+	stream.add(3, ActionLinodeBoot, EventFinished, 200)
+
+	delivery := receiveEvent(t, all)
+	require.Equal(t, 2, delivery.Event.ID)
+	require.Equal(t, EventStarted, delivery.Event.Status)
+
+	started := delivery.Cursor
+	require.Equal(t, EventCursor{LastEventID: 2, Pending: map[int]EventStatus{2: EventStarted}}, started)
+
+	require.Equal(t, 3, receiveEvent(t, all).Event.ID)
+
+	// Status transitions are delivered separately
+	stream.setStatus(2, EventFinished)
+
+	delivery = receiveEvent(t, all)
+	require.Equal(t, 2, delivery.Event.ID)
+	require.Equal(t, EventFinished, delivery.Event.Status)
+	require.Equal(t, EventCursor{LastEventID: 3, Pending: map[int]EventStatus{}}, delivery.Cursor)
+
+	delivery = receiveEvent(t, booted)
+	require.Equal(t, 2, delivery.Event.ID)
+	require.Equal(t, EventFinished, delivery.Event.Status)
+
+	// Watches can be resumed from a cursor
+	resumed, err := client.WatchEvents(ctx, EventWatchOptions{Cursor: &started})
+	require.NoError(t, err)
+
+	delivery = receiveEvent(t, resumed)
+	require.Equal(t, 2, delivery.Event.ID)
+	require.Equal(t, EventFinished, delivery.Event.Status)
+
+	require.Equal(t, 3, receiveEvent(t, resumed).Event.ID)
+
+	// All watches share a single poll loop
+	stream.mu.Lock()
+	stream.polls = 0
+	stream.mu.Unlock()
+
+	time.Sleep(100 * time.Millisecond)
+
+	stream.mu.Lock()
+	polls := stream.polls
+	stream.mu.Unlock()
+
+	require.LessOrEqual(t, polls, 11)
+
+	all.Close()
+
+	_, ok := <-all.Events()
+	require.False(t, ok)
+
+	cancel()
+
+	_, ok = <-booted.Events()
+	require.False(t, ok)
+}
+
+func TestClient_WatchEventsMaxLookback(t *testing.T) {
+	stream := &fakeEventStream{}
+	stream.addCreated(1, ActionLinodeBoot, EventStarted, 100, time.Now().Add(-2*time.Hour))
+	stream.addCreated(2, ActionLinodeBoot, EventStarted, 100, time.Now().Add(-2*time.Hour))
+	stream.add(3, ActionLinodeBoot, EventStarted, 100)
+
+	server := httptest.NewServer(stream)
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(10 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Resuming from an old cursor skips events older than the lookback,
+	// and stops tracking old or missing pending events
+	watch, err := client.WatchEvents(ctx, EventWatchOptions{
+		Cursor: &EventCursor{
+			LastEventID: 0,
+			Pending:     map[int]EventStatus{1: EventStarted, 99: EventStarted},
+		},
+		MaxLookback: time.Hour,
+	})
+	require.NoError(t, err)
+
+	delivery := receiveEvent(t, watch)
+	require.Equal(t, 3, delivery.Event.ID)
+	require.Equal(t, EventCursor{LastEventID: 3, Pending: map[int]EventStatus{3: EventStarted}}, delivery.Cursor)
+
+	stream.setStatus(3, EventFinished)
+
+	delivery = receiveEvent(t, watch)
+	require.Equal(t, 3, delivery.Event.ID)
+	require.Equal(t, EventFinished, delivery.Event.Status)
+}
+
+func TestClient_WatchEventsPollError(t *testing.T) {
+	stream := &fakeEventStream{}
+
+	server := httptest.NewServer(stream)
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(10 * time.Millisecond)
+
+	// Pending events are checked using bounded queries
+	pending := make(map[int]EventStatus)
+	for id := 1; id <= 120; id++ {
+		pending[id] = EventStarted
+	}
+
+	watch, err := client.WatchEvents(context.Background(), EventWatchOptions{
+		Cursor: &EventCursor{LastEventID: 120, Pending: pending},
+	})
+	require.NoError(t, err)
+
+	stream.add(121, ActionLinodeBoot, EventStarted, 100)
+	require.Equal(t, 121, receiveEvent(t, watch).Event.ID)
+	require.NoError(t, watch.Err())
+
+	stream.mu.Lock()
+	require.Equal(t, maxEventFilterTargets, stream.maxIDs)
+	stream.status = http.StatusUnauthorized
+	stream.mu.Unlock()
+
+	// Errors that are not transient end the watch
+	select {
+	case _, ok := <-watch.Events():
+		require.False(t, ok)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for the watch to end")
+	}
+
+	require.True(t, ErrHasStatus(watch.Err(), http.StatusUnauthorized))
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -44,6 +45,12 @@ func (c *Client) ListInvoices(ctx context.Context, opts *ListOptions) ([]Invoice
 	return getPaginatedResults[Invoice](ctx, c, "account/invoices", opts)
 }
 
+// IterInvoices returns an iterator over the results of ListInvoices,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterInvoices(ctx context.Context, opts *ListOptions) iter.Seq2[Invoice, error] {
+	return getPaginatedIter[Invoice](ctx, c, "account/invoices", opts)
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (i *Invoice) UnmarshalJSON(b []byte) error {
 	type Mask Invoice
@@ -98,3 +105,9 @@ func (c *Client) GetInvoice(ctx context.Context, invoiceID int) (*Invoice, error
 func (c *Client) ListInvoiceItems(ctx context.Context, invoiceID int, opts *ListOptions) ([]InvoiceItem, error) {
 	return getPaginatedResults[InvoiceItem](ctx, c, formatAPIPath("account/invoices/%d/items", invoiceID), opts)
 }
+
+// IterInvoiceItems returns an iterator over the results of ListInvoiceItems,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterInvoiceItems(ctx context.Context, invoiceID int, opts *ListOptions) iter.Seq2[InvoiceItem, error] {
+	return getPaginatedIter[InvoiceItem](ctx, c, formatAPIPath("account/invoices/%d/items", invoiceID), opts)
+}
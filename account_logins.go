@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -21,6 +22,12 @@ func (c *Client) ListLogins(ctx context.Context, opts *ListOptions) ([]Login, er
 	return getPaginatedResults[Login](ctx, c, "account/logins", opts)
 }
 
+// IterLogins returns an iterator over the results of ListLogins,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterLogins(ctx context.Context, opts *ListOptions) iter.Seq2[Login, error] {
+	return getPaginatedIter[Login](ctx, c, "account/logins", opts)
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (i *Login) UnmarshalJSON(b []byte) error {
 	type Mask Login
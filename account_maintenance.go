@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -64,3 +65,9 @@ func (accountMaintenance *AccountMaintenance) UnmarshalJSON(b []byte) error {
 func (c *Client) ListMaintenances(ctx context.Context, opts *ListOptions) ([]AccountMaintenance, error) {
 	return getPaginatedResults[AccountMaintenance](ctx, c, "account/maintenance", opts)
 }
+
+// IterMaintenances returns an iterator over the results of ListMaintenances,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterMaintenances(ctx context.Context, opts *ListOptions) iter.Seq2[AccountMaintenance, error] {
+	return getPaginatedIter[AccountMaintenance](ctx, c, "account/maintenance", opts)
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -66,6 +67,12 @@ func (c *Client) ListNotifications(ctx context.Context, opts *ListOptions) ([]No
 	return getPaginatedResults[Notification](ctx, c, "account/notifications", opts)
 }
 
+// IterNotifications returns an iterator over the results of ListNotifications,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterNotifications(ctx context.Context, opts *ListOptions) iter.Seq2[Notification, error] {
+	return getPaginatedIter[Notification](ctx, c, "account/notifications", opts)
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (i *Notification) UnmarshalJSON(b []byte) error {
 	type Mask Notification
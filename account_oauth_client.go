@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // OAuthClientStatus constants start with OAuthClient and include Linode API Instance Status values
@@ -85,6 +86,12 @@ func (c *Client) ListOAuthClients(ctx context.Context, opts *ListOptions) ([]OAu
 	return getPaginatedResults[OAuthClient](ctx, c, "account/oauth-clients", opts)
 }
 
+// IterOAuthClients returns an iterator over the results of ListOAuthClients,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterOAuthClients(ctx context.Context, opts *ListOptions) iter.Seq2[OAuthClient, error] {
+	return getPaginatedIter[OAuthClient](ctx, c, "account/oauth-clients", opts)
+}
+
 // GetOAuthClient gets the OAuthClient with the provided ID
 func (c *Client) GetOAuthClient(ctx context.Context, clientID string) (*OAuthClient, error) {
 	e := formatAPIPath("account/oauth-clients/%s", clientID)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -144,6 +145,12 @@ func (c *Client) ListPaymentMethods(ctx context.Context, opts *ListOptions) ([]P
 	return getPaginatedResults[PaymentMethod](ctx, c, "account/payment-methods", opts)
 }
 
+// IterPaymentMethods returns an iterator over the results of ListPaymentMethods,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterPaymentMethods(ctx context.Context, opts *ListOptions) iter.Seq2[PaymentMethod, error] {
+	return getPaginatedIter[PaymentMethod](ctx, c, "account/payment-methods", opts)
+}
+
 // GetPaymentMethod gets the payment method with the provided ID
 func (c *Client) GetPaymentMethod(ctx context.Context, paymentMethodID int) (*PaymentMethod, error) {
 	e := formatAPIPath("account/payment-methods/%d", paymentMethodID)
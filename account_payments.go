@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -61,6 +62,12 @@ func (c *Client) ListPayments(ctx context.Context, opts *ListOptions) ([]Payment
 	return getPaginatedResults[Payment](ctx, c, "account/payments", opts)
 }
 
+// IterPayments returns an iterator over the results of ListPayments,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterPayments(ctx context.Context, opts *ListOptions) iter.Seq2[Payment, error] {
+	return getPaginatedIter[Payment](ctx, c, "account/payments", opts)
+}
+
 // GetPayment gets the payment with the provided ID
 func (c *Client) GetPayment(ctx context.Context, paymentID int) (*Payment, error) {
 	e := formatAPIPath("account/payments/%d", paymentID)
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -74,6 +75,12 @@ func (c *Client) ListAccountServiceTransfer(ctx context.Context, opts *ListOptio
 	return getPaginatedResults[AccountServiceTransfer](ctx, c, "account/service-transfers", opts)
 }
 
+// IterAccountServiceTransfer returns an iterator over the results of ListAccountServiceTransfer,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterAccountServiceTransfer(ctx context.Context, opts *ListOptions) iter.Seq2[AccountServiceTransfer, error] {
+	return getPaginatedIter[AccountServiceTransfer](ctx, c, "account/service-transfers", opts)
+}
+
 // GetAccountServiceTransfer gets the details of the AccountServiceTransfer for the provided token.
 func (c *Client) GetAccountServiceTransfer(ctx context.Context, token string) (*AccountServiceTransfer, error) {
 	e := formatAPIPath("account/service-transfers/%s", token)
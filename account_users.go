@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -115,6 +116,12 @@ func (c *Client) ListUsers(ctx context.Context, opts *ListOptions) ([]User, erro
 	return getPaginatedResults[User](ctx, c, "account/users", opts)
 }
 
+// IterUsers returns an iterator over the results of ListUsers,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterUsers(ctx context.Context, opts *ListOptions) iter.Seq2[User, error] {
+	return getPaginatedIter[User](ctx, c, "account/users", opts)
+}
+
 // GetUser gets the user with the provided ID
 func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
 	e := formatAPIPath("account/users/%s", userID)
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -57,6 +58,12 @@ func (c *Client) ListBetaPrograms(ctx context.Context, opts *ListOptions) ([]Bet
 	return getPaginatedResults[BetaProgram](ctx, c, "/betas", opts)
 }
 
+// IterBetaPrograms returns an iterator over the results of ListBetaPrograms,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterBetaPrograms(ctx context.Context, opts *ListOptions) iter.Seq2[BetaProgram, error] {
+	return getPaginatedIter[BetaProgram](ctx, c, "/betas", opts)
+}
+
 // GetBetaProgram gets the beta program's detail with the ID
 func (c *Client) GetBetaProgram(ctx context.Context, betaID string) (*BetaProgram, error) {
 	e := formatAPIPath("betas/%s", betaID)
@@ -0,0 +1,274 @@
+package linodego
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// APIDefaultCacheMaxEntries is the default maximum number of entries held by the in-memory response cache
+	APIDefaultCacheMaxEntries = 1024
+	// APIDefaultCacheMaxBytes is the default maximum total size of the data held by the in-memory response cache
+	APIDefaultCacheMaxBytes = 32 << 20
+)
+
+// CacheEntry is a single cached response.
+type CacheEntry struct {
+	Created time.Time       `json:"created"`
+	Data    json.RawMessage `json:"data"`
+	// If != nil, use this instead of the
+	// global expiry
+	ExpiryOverride *time.Duration `json:"expiry_override,omitempty"`
+}
+
+// ResponseCache is a storage backend for cached API responses.
+// Expiration is evaluated by the Client, so implementations only need to
+// store and evict entries. Implementations must be safe for concurrent use.
+type ResponseCache interface {
+	// Get returns the entry stored for the given key, if any.
+	Get(key string) (*CacheEntry, bool)
+	// Set stores the given entry, replacing any existing entry for the key.
+	Set(key string, entry CacheEntry) error
+	// Delete removes the entry stored for the given key, if any.
+	Delete(key string) error
+	// Purge removes all entries from the cache.
+	Purge() error
+}
+
+// MemoryCacheOptions configures the limits of a MemoryCache.
+type MemoryCacheOptions struct {
+	// MaxEntries is the maximum number of entries to keep. 0 means no limit.
+	MaxEntries int
+	// MaxBytes is the maximum total size of the cached data in bytes. 0 means no limit.
+	MaxBytes int
+}
+
+// MemoryCache is an in-memory ResponseCache that evicts the least recently used
+// entries once its entry or size limits are reached.
+type MemoryCache struct {
+	options MemoryCacheOptions
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+	size    int
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry CacheEntry
+}
+
+var _ ResponseCache = (*MemoryCache)(nil)
+
+// NewMemoryCache creates a new in-memory LRU ResponseCache with the given limits.
+func NewMemoryCache(options MemoryCacheOptions) *MemoryCache {
+	return &MemoryCache{
+		options: options,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Get returns the entry stored for the given key and marks it as recently used.
+func (m *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	m.order.MoveToFront(elem)
+
+	entry := elem.Value.(*memoryCacheItem).entry
+
+	return &entry, true
+}
+
+// Set stores the given entry and evicts the least recently used entries
+// until the cache is within its limits.
+func (m *MemoryCache) Set(key string, entry CacheEntry) error {
+	if m.options.MaxBytes > 0 && len(entry.Data) > m.options.MaxBytes {
+		return fmt.Errorf("cache entry for %s exceeds the maximum cache size of %d bytes", key, m.options.MaxBytes)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.entries[key]; ok {
+		m.removeElement(elem)
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryCacheItem{key: key, entry: entry})
+	m.size += len(entry.Data)
+
+	for m.overLimit() {
+		m.removeElement(m.order.Back())
+	}
+
+	return nil
+}
+
+// Delete removes the entry stored for the given key.
+func (m *MemoryCache) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.entries[key]; ok {
+		m.removeElement(elem)
+	}
+
+	return nil
+}
+
+// Purge removes all entries from the cache.
+func (m *MemoryCache) Purge() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries = make(map[string]*list.Element)
+	m.order.Init()
+	m.size = 0
+
+	return nil
+}
+
+// Len returns the number of entries currently in the cache.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.order.Len()
+}
+
+func (m *MemoryCache) overLimit() bool {
+	if m.options.MaxEntries > 0 && m.order.Len() > m.options.MaxEntries {
+		return true
+	}
+
+	return m.options.MaxBytes > 0 && m.size > m.options.MaxBytes
+}
+
+func (m *MemoryCache) removeElement(elem *list.Element) {
+	item := m.order.Remove(elem).(*memoryCacheItem)
+	delete(m.entries, item.key)
+	m.size -= len(item.entry.Data)
+}
+
+// FileCache is a ResponseCache that stores each entry as a JSON file in a directory,
+// allowing cached responses to be shared between processes and across runs.
+// The directory should be dedicated to the cache. Clients scope their keys to their
+// API host, API version and token, so a FileCache can be shared between clients
+// of different accounts or environments.
+type FileCache struct {
+	dir string
+}
+
+type fileCacheEntry struct {
+	Key string `json:"key"`
+	CacheEntry
+}
+
+const fileCachePrefix = "linodego-"
+
+var _ ResponseCache = (*FileCache)(nil)
+
+// NewFileCache creates a new file-backed ResponseCache in the given directory,
+// creating the directory if it does not exist.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory %s: %w", dir, err)
+	}
+
+	return &FileCache{dir: dir}, nil
+}
+
+// Get returns the entry stored for the given key.
+// Unreadable or corrupt entries are treated as missing.
+func (f *FileCache) Get(key string) (*CacheEntry, bool) {
+	data, err := os.ReadFile(f.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry fileCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Key != key {
+		return nil, false
+	}
+
+	return &entry.CacheEntry, true
+}
+
+// Set writes the given entry to disk, replacing any existing entry for the key.
+func (f *FileCache) Set(key string, entry CacheEntry) error {
+	data, err := json.Marshal(fileCacheEntry{Key: key, CacheEntry: entry})
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache entry: %w", err)
+	}
+
+	// Write to a temporary file first so concurrent readers never see a partial entry
+	tmp, err := os.CreateTemp(f.dir, fileCachePrefix+"*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), f.path(key)); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the entry stored for the given key.
+func (f *FileCache) Delete(key string) error {
+	if err := os.Remove(f.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete cache file: %w", err)
+	}
+
+	return nil
+}
+
+// Purge removes all cache entries from the cache directory.
+func (f *FileCache) Purge() error {
+	matches, err := filepath.Glob(filepath.Join(f.dir, fileCachePrefix+"*"))
+	if err != nil {
+		return err
+	}
+
+	for _, match := range matches {
+		if err := os.Remove(match); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to delete cache file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, fileCachePrefix+hex.EncodeToString(sum[:])+".json")
+}
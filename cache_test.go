@@ -0,0 +1,134 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewMemoryCache(MemoryCacheOptions{MaxEntries: 2})
+
+	require.NoError(t, cache.Set("a", CacheEntry{Data: json.RawMessage(`1`)}))
+	require.NoError(t, cache.Set("b", CacheEntry{Data: json.RawMessage(`2`)}))
+
+	// Mark "a" as recently used so "b" is evicted next
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+
+	require.NoError(t, cache.Set("c", CacheEntry{Data: json.RawMessage(`3`)}))
+
+	_, ok = cache.Get("b")
+	require.False(t, ok, "expected least recently used entry to be evicted")
+
+	_, ok = cache.Get("a")
+	require.True(t, ok)
+
+	_, ok = cache.Get("c")
+	require.True(t, ok)
+
+	require.Equal(t, 2, cache.Len())
+}
+
+func TestMemoryCache_MaxBytes(t *testing.T) {
+	cache := NewMemoryCache(MemoryCacheOptions{MaxBytes: 8})
+
+	require.NoError(t, cache.Set("a", CacheEntry{Data: json.RawMessage(`"aaa"`)}))
+	require.NoError(t, cache.Set("b", CacheEntry{Data: json.RawMessage(`"bbb"`)}))
+
+	_, ok := cache.Get("a")
+	require.False(t, ok, "expected entry to be evicted once the size limit was exceeded")
+	require.Equal(t, 1, cache.Len())
+
+	require.Error(t, cache.Set("c", CacheEntry{Data: json.RawMessage(`"ccccccccc"`)}))
+
+	require.NoError(t, cache.Purge())
+	require.Equal(t, 0, cache.Len())
+}
+
+func TestFileCache_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+
+	cache, err := NewFileCache(dir)
+	require.NoError(t, err)
+
+	expiry := time.Minute
+	entry := CacheEntry{
+		Created:        time.Now().UTC().Truncate(time.Second),
+		Data:           json.RawMessage(`{"id":"us-east"}`),
+		ExpiryOverride: &expiry,
+	}
+
+	require.NoError(t, cache.Set("regions/us-east", entry))
+
+	// A second cache in the same directory should see the entry
+	other, err := NewFileCache(dir)
+	require.NoError(t, err)
+
+	result, ok := other.Get("regions/us-east")
+	require.True(t, ok)
+	require.True(t, entry.Created.Equal(result.Created))
+	require.JSONEq(t, string(entry.Data), string(result.Data))
+	require.Equal(t, expiry, *result.ExpiryOverride)
+
+	require.NoError(t, other.Delete("regions/us-east"))
+
+	_, ok = cache.Get("regions/us-east")
+	require.False(t, ok)
+
+	require.NoError(t, cache.Set("a", entry))
+	require.NoError(t, cache.Set("b", entry))
+	require.NoError(t, cache.Purge())
+
+	_, ok = cache.Get("a")
+	require.False(t, ok)
+}
+
+func TestClient_CachedResponse(t *testing.T) {
+	client := newTestClient(t, nil)
+	client.SetResponseCache(NewMemoryCache(MemoryCacheOptions{}))
+
+	built := time.Date(2018, 1, 1, 0, 1, 1, 0, time.UTC)
+	kernel := LinodeKernel{ID: "linode/latest-64bit", Built: &built}
+
+	client.addCachedResponse(context.Background(), "linode/kernels/latest", &kernel, nil)
+
+	result := getCachedResponse[LinodeKernel](context.Background(), &client, "linode/kernels/latest")
+	require.NotNil(t, result)
+	require.Equal(t, kernel.ID, result.ID)
+	require.True(t, built.Equal(*result.Built))
+
+	// Cached responses should not share memory with the original value
+	result.ID = "changed"
+	require.Equal(t, "linode/latest-64bit", getCachedResponse[LinodeKernel](context.Background(), &client, "linode/kernels/latest").ID)
+
+	client.SetGlobalCacheExpiration(0)
+	require.Nil(t, getCachedResponse[LinodeKernel](context.Background(), &client, "linode/kernels/latest"))
+}
+
+func TestClient_CachedResponseScopedToClient(t *testing.T) {
+	cache, err := NewFileCache(t.TempDir())
+	require.NoError(t, err)
+
+	client := newTestClient(t, nil)
+	client.SetResponseCache(cache).SetToken("prod-token")
+
+	kernel := LinodeKernel{ID: "linode/latest-64bit"}
+	client.addCachedResponse(context.Background(), "linode/kernels/latest", &kernel, nil)
+
+	require.NotNil(t, getCachedResponse[LinodeKernel](context.Background(), &client, "linode/kernels/latest"))
+
+	otherAccount := newTestClient(t, nil)
+	otherAccount.SetResponseCache(cache).SetToken("other-token")
+	require.Nil(t, getCachedResponse[LinodeKernel](context.Background(), &otherAccount, "linode/kernels/latest"))
+
+	staging := newTestClient(t, nil)
+	staging.SetResponseCache(cache).SetToken("prod-token").SetBaseURL("api.staging.example.com")
+	require.Nil(t, getCachedResponse[LinodeKernel](context.Background(), &staging, "linode/kernels/latest"))
+
+	require.NoError(t, client.InvalidateCacheEndpoint("linode/kernels/latest"))
+	require.Nil(t, getCachedResponse[LinodeKernel](context.Background(), &client, "linode/kernels/latest"))
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -20,7 +21,6 @@ import (
 	"sort"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 )
 
@@ -118,21 +118,40 @@ type Client struct {
 
 	configProfiles map[string]ConfigProfile
 
+	// profileHTTPClients is shared by copies of the client, so the HTTP client
+	// for the root certificate of a profile is only created once
+	profileHTTPClients *profileHTTPClients
+
 	// Fields for caching endpoint responses
 	shouldCache     bool
 	cacheExpiration time.Duration
-	cachedEntries   map[string]clientCacheEntry
-	cachedEntryLock *sync.RWMutex
+	cache           ResponseCache
 	logger          Logger
 	requestLog      func(*RequestLog) error
 	onBeforeRequest []func(*http.Request) error
 	onAfterResponse []func(*http.Response) error
 
-	retryConditionals []RetryConditional
-	retryMaxWaitTime  time.Duration
-	retryMinWaitTime  time.Duration
-	retryAfter        RetryAfter
-	retryCount        int
+	retryConditionals   []RetryConditional
+	retryMaxWaitTime    time.Duration
+	retryMinWaitTime    time.Duration
+	retryMaxElapsedTime time.Duration
+	retryAfter          RetryAfter
+	retryBackoff        BackoffStrategy
+	retryCount          int
+
+	rateLimiter *RateLimiter
+
+	requestObservers []RequestObserver
+
+	dryRun *dryRunPlan
+
+	autoIdempotencyKeys bool
+
+	credentialProvider CredentialProvider
+
+	pageConcurrency int
+
+	eventHub *eventHub
 }
 
 type EnvDefaults struct {
@@ -140,14 +159,6 @@ type EnvDefaults struct {
 	Profile string
 }
 
-type clientCacheEntry struct {
-	Created time.Time
-	Data    any
-	// If != nil, use this instead of the
-	// global expiry
-	ExpiryOverride *time.Duration
-}
-
 type (
 	Request  = http.Request
 	Response = http.Response
@@ -165,8 +176,31 @@ func init() {
 }
 
 // NewClient factory to create new Client struct.
-// nolint:funlen
 func NewClient(hc *http.Client) (client Client, err error) {
+	client = newClient(hc)
+
+	certPath, certPathExists := os.LookupEnv(APIHostCert)
+
+	if certPathExists { //nolint:nestif
+		if _, ok := client.httpClient.Transport.(*http.Transport); ok {
+			if err := client.SetRootCertificate(certPath); err != nil {
+				return Client{}, err
+			}
+
+			if envDebug {
+				log.Printf("[DEBUG] Set API root certificate to %s\n", certPath)
+			}
+		} else {
+			log.Println("[WARN] Custom root certificate is not supported with a custom transport")
+		}
+	}
+
+	return client, nil
+}
+
+// newClient creates a Client with the default configuration,
+// without loading a root certificate from the environment.
+func newClient(hc *http.Client) (client Client) {
 	if hc != nil {
 		client.httpClient = hc
 	} else {
@@ -180,9 +214,13 @@ func NewClient(hc *http.Client) (client Client, err error) {
 
 	client.shouldCache = true
 	client.cacheExpiration = APIDefaultCacheExpiration
-	client.cachedEntries = make(map[string]clientCacheEntry)
-	client.cachedEntryLock = &sync.RWMutex{}
+	client.cache = NewMemoryCache(MemoryCacheOptions{
+		MaxEntries: APIDefaultCacheMaxEntries,
+		MaxBytes:   APIDefaultCacheMaxBytes,
+	})
 	client.configProfiles = make(map[string]ConfigProfile)
+	client.profileHTTPClients = &profileHTTPClients{}
+	client.eventHub = newEventHub()
 
 	const (
 		retryMinWaitDuration = 100 * time.Millisecond
@@ -191,6 +229,7 @@ func NewClient(hc *http.Client) (client Client, err error) {
 
 	client.retryMinWaitTime = retryMinWaitDuration
 	client.retryMaxWaitTime = retryMaxWaitDuration
+	client.retryBackoff = ConstantBackoff
 
 	client.SetUserAgent(DefaultUserAgent)
 	client.SetLogger(createLogger())
@@ -207,22 +246,6 @@ func NewClient(hc *http.Client) (client Client, err error) {
 		client.SetAPIVersion(APIVersion)
 	}
 
-	certPath, certPathExists := os.LookupEnv(APIHostCert)
-
-	if certPathExists { //nolint:nestif
-		if _, ok := client.httpClient.Transport.(*http.Transport); ok {
-			if err := client.SetRootCertificate(certPath); err != nil {
-				return Client{}, err
-			}
-
-			if envDebug {
-				log.Printf("[DEBUG] Set API root certificate to %s\n", certPath)
-			}
-		} else {
-			log.Println("[WARN] Custom root certificate is not supported with a custom transport")
-		}
-	}
-
 	client.
 		SetRetryWaitTime(APISecondsPerPoll * time.Second).
 		SetPollDelay(APISecondsPerPoll * time.Second).
@@ -230,7 +253,7 @@ func NewClient(hc *http.Client) (client Client, err error) {
 		SetDebug(envDebug).
 		enableLogSanitization()
 
-	return client, nil
+	return client
 }
 
 // NewClientFromEnv creates a Client and initializes it with values
@@ -372,6 +395,14 @@ func (c *Client) OnAfterResponse(m func(*http.Response) error) {
 	c.onAfterResponse = append(c.onAfterResponse, m)
 }
 
+// AddRequestObserver registers an observer that is notified around each API call
+// and each attempt of that call. Observers are started in the order they are added.
+func (c *Client) AddRequestObserver(observer RequestObserver) *Client {
+	c.requestObservers = append(c.requestObservers, observer)
+
+	return c
+}
+
 // UseURL parses the individual components of the given API URL and configures the client
 // accordingly. For example, a valid URL.
 // For example:
@@ -429,11 +460,9 @@ func (c *Client) SetAPIVersion(apiVersion string) *Client {
 
 // InvalidateCache clears all cached responses for all endpoints.
 func (c *Client) InvalidateCache() {
-	c.cachedEntryLock.Lock()
-	defer c.cachedEntryLock.Unlock()
-
-	// GC will handle the old map
-	c.cachedEntries = make(map[string]clientCacheEntry)
+	if err := c.cache.Purge(); err != nil {
+		_ = c.ErrorAndLogf("failed to purge cache: %v", err.Error())
+	}
 }
 
 // InvalidateCacheEndpoint invalidates a single cached endpoint.
@@ -443,14 +472,25 @@ func (c *Client) InvalidateCacheEndpoint(endpoint string) error {
 		return fmt.Errorf("failed to parse URL for caching: %w", err)
 	}
 
-	c.cachedEntryLock.Lock()
-	defer c.cachedEntryLock.Unlock()
+	key, ok := c.cacheKey(context.Background(), u.Path)
+	if !ok {
+		return nil
+	}
 
-	delete(c.cachedEntries, u.Path)
+	if err := c.cache.Delete(key); err != nil {
+		return fmt.Errorf("failed to invalidate cached endpoint: %w", err)
+	}
 
 	return nil
 }
 
+// SetResponseCache sets the backend used to store cached responses.
+// By default, responses are cached in a bounded in-memory LRU cache.
+func (c *Client) SetResponseCache(cache ResponseCache) *Client {
+	c.cache = cache
+	return c
+}
+
 // SetGlobalCacheExpiration sets the desired time for any cached response
 // to be valid for.
 func (c *Client) SetGlobalCacheExpiration(expiryTime time.Duration) {
@@ -481,6 +521,38 @@ func (c *Client) SetRetryAfter(callback RetryAfter) *Client {
 	return c
 }
 
+// SetBackoffStrategy sets the function used to determine the delay before retrying a request
+// when the API does not provide a Retry-After duration.
+func (c *Client) SetBackoffStrategy(strategy BackoffStrategy) *Client {
+	c.retryBackoff = strategy
+	return c
+}
+
+// SetRetryMaxElapsedTime sets the total time budget for retrying a single request.
+// Once the budget would be exceeded by the next delay, the last error is returned.
+// A value of 0 disables the budget.
+func (c *Client) SetRetryMaxElapsedTime(maxElapsedTime time.Duration) *Client {
+	c.retryMaxElapsedTime = maxElapsedTime
+	return c
+}
+
+// SetRateLimiter sets the RateLimiter used to throttle outgoing requests.
+// A RateLimiter may be shared between multiple clients.
+// Passing nil disables client-side rate limiting.
+func (c *Client) SetRateLimiter(limiter *RateLimiter) *Client {
+	c.rateLimiter = limiter
+	return c
+}
+
+// SetPageConcurrency sets the maximum number of pages fetched concurrently
+// by List* functions once the total number of pages is known.
+// Results are always returned in page order.
+// Values <= 1 fetch pages sequentially, which is the default.
+func (c *Client) SetPageConcurrency(concurrency int) *Client {
+	c.pageConcurrency = concurrency
+	return c
+}
+
 // SetRetryCount sets the number of retries after the initial request before aborting.
 // Negative values are treated as 0 (no retries).
 func (c *Client) SetRetryCount(count int) *Client {
@@ -528,105 +600,273 @@ func (c *Client) Transport() (*http.Transport, error) {
 // Generic helper to execute HTTP requests using the net/http package
 //
 // nolint:funlen, gocognit, nestif
-func (c *Client) doRequest(ctx context.Context, method, endpoint string, params requestParams, paginationMutator *func(*http.Request) error) error {
+func (c *Client) doRequest(
+	ctx context.Context,
+	method, endpoint string,
+	params requestParams,
+	paginationMutator *func(*http.Request) error,
+) (err error) {
+	if c.dryRun != nil && isDryRunMethod(method) {
+		return c.planRequest(method, endpoint, params)
+	}
+
 	var (
-		req  *http.Request
-		resp *http.Response
-		err  error
+		resp    *http.Response
+		sent    bool
+		attempt int
 	)
 
+	var waitTime time.Duration
+
+	ctx, cancel := withCallTimeout(ctx)
+	defer cancel()
+
+	options := requestOptionsFromContext(ctx)
+
+	retryCount := c.retryCount
+	if options.disableRetries {
+		retryCount = 0
+	}
+
+	firstAttempt := time.Now()
+
+	event := RequestEvent{
+		Method:   method,
+		Endpoint: endpoint,
+	}
+
+	if len(c.requestObservers) > 0 {
+		event.EndpointTemplate = RequestEndpointTemplate(endpoint)
+	}
+
+	ctx, finishCall := startRequestObservation(ctx, c.requestObservers, event, RequestObserver.StartCall)
+
+	defer func() {
+		finishCall(attempt+1, resp, err)
+		captureResponseMeta(ctx, attempt+1, resp)
+	}()
+
 	// retryCount controls the number of retries after the initial attempt
-	for range c.retryCount + 1 {
-		// createRequest seeks params.Body back to the start, so it's safe to retry.
-		req, err = c.createRequest(ctx, method, endpoint, params)
-		if err != nil {
+	for attempt = range retryCount + 1 {
+		event.Attempt = attempt + 1
+
+		attemptCtx, finishAttempt := startRequestObservation(
+			ctx, c.requestObservers, event, RequestObserver.StartAttempt,
+		)
+
+		resp, sent, err = c.doRequestAttempt(attemptCtx, method, endpoint, params, paginationMutator)
+
+		finishAttempt(attempt+1, resp, err)
+
+		// Errors raised before the request was sent are not retryable
+		if err == nil || !sent {
 			return err
 		}
 
-		if paginationMutator != nil {
-			if mutErr := (*paginationMutator)(req); mutErr != nil {
-				return c.ErrorAndLogf("failed to mutate before request: %v", mutErr.Error())
-			}
+		if attempt == retryCount || !c.shouldRetry(resp, err) {
+			break
 		}
 
-		if err = c.applyBeforeRequest(req); err != nil {
-			return err
+		var retryErr error
+
+		waitTime, retryErr = c.retryWaitTime(resp, attempt+1, waitTime)
+		if retryErr != nil {
+			return retryErr
 		}
 
-		if c.debug && c.logger != nil {
-			req = c.logRequest(req)
+		if c.retryMaxElapsedTime > 0 && time.Since(firstAttempt)+waitTime > c.retryMaxElapsedTime {
+			log.Printf("[INFO] Retry budget of %s exhausted, giving up", c.retryMaxElapsedTime)
+			break
 		}
 
-		processResponse := func(start, end time.Time) error {
-			defer func() {
-				closeErr := resp.Body.Close()
-				if closeErr != nil && err == nil {
-					err = closeErr
-				}
-			}()
+		// Wait for the calculated duration before retrying, aborting early
+		// if the context is canceled or its deadline is exceeded.
+		if waitErr := sleepContext(ctx, waitTime); waitErr != nil {
+			return c.ErrorAndLogf("retry aborted: %w (last error: %v)", waitErr, err)
+		}
+	}
 
-			if err = c.checkHTTPError(resp); err != nil {
-				return err
-			}
+	return err
+}
 
-			if c.debug && c.logger != nil {
-				resp = c.logResponse(resp, start, end)
-			}
+// doRequestAttempt performs a single attempt of a request.
+// sent reports whether the request was sent to the API.
+func (c *Client) doRequestAttempt(
+	ctx context.Context,
+	method, endpoint string,
+	params requestParams,
+	paginationMutator *func(*http.Request) error,
+) (resp *http.Response, sent bool, err error) {
+	req, err := c.prepareRequest(ctx, method, endpoint, params, paginationMutator)
+	if err != nil {
+		return nil, false, err
+	}
 
-			if params.Response != nil {
-				if err = c.decodeResponseBody(resp, params.Response); err != nil {
-					return err
-				}
+	processResponse := func(start, end time.Time) error {
+		defer func() {
+			closeErr := resp.Body.Close()
+			if closeErr != nil && err == nil {
+				err = closeErr
 			}
+		}()
+
+		if err = c.checkHTTPError(resp); err != nil {
+			return err
+		}
 
-			// Apply after-response mutations
-			if err = c.applyAfterResponse(resp); err != nil {
+		if c.debug && c.logger != nil {
+			resp = c.logResponse(resp, start, end)
+		}
+
+		if params.Response != nil {
+			if err = c.decodeResponseBody(resp, params.Response); err != nil {
 				return err
 			}
+		}
 
-			return nil
+		// Apply after-response mutations
+		if err = c.applyAfterResponse(resp); err != nil {
+			return err
 		}
 
-		startTime := time.Now()
-		resp, err = c.sendRequest(req)
-		endTime := time.Now()
+		return nil
+	}
 
-		if err == nil {
-			if err = processResponse(startTime, endTime); err == nil {
-				return nil
-			}
+	if err = c.waitForRateLimit(ctx, req); err != nil {
+		return nil, false, err
+	}
+
+	startTime := time.Now()
+	resp, err = c.sendRequest(req)
+
+	// Retry once with fresh credentials if the credentials were rejected
+	if err == nil && resp.StatusCode == http.StatusUnauthorized && c.credentialProvider != nil &&
+		c.invalidateCredentials() {
+		if c.rateLimiter != nil {
+			c.rateLimiter.Update(resp)
 		}
 
-		if !c.shouldRetry(resp, err) {
-			break
+		_ = resp.Body.Close()
+
+		if req, err = c.prepareRequest(ctx, method, endpoint, params, paginationMutator); err != nil {
+			return nil, true, err
 		}
 
-		retryAfter, retryErr := c.retryAfter(resp)
-		if retryErr != nil {
-			return retryErr
+		// The retried request counts against the rate limit like any other request
+		if err = c.waitForRateLimit(ctx, req); err != nil {
+			return nil, true, err
 		}
 
-		// Determine wait time before retrying.
-		// If the server provided a Retry-After duration, use it (clamped to bounds).
-		// Otherwise, fall back to the configured minimum wait time.
-		waitTime := c.retryMinWaitTime
+		resp, err = c.sendRequest(req)
+	}
+
+	endTime := time.Now()
+
+	if c.rateLimiter != nil && resp != nil {
+		c.rateLimiter.Update(resp)
+	}
+
+	if err == nil {
+		err = processResponse(startTime, endTime)
+	}
+
+	return resp, true, err
+}
+
+// waitForRateLimit blocks until the client's rate limiter, if any, allows the request to be sent.
+func (c *Client) waitForRateLimit(ctx context.Context, req *http.Request) error {
+	if c.rateLimiter == nil {
+		return nil
+	}
+
+	if err := c.rateLimiter.Wait(ctx, req); err != nil {
+		return c.ErrorAndLogf("failed to wait for rate limiter: %w", err)
+	}
+
+	return nil
+}
+
+// prepareRequest creates a request and applies the client's request mutations to it.
+func (c *Client) prepareRequest(
+	ctx context.Context,
+	method, endpoint string,
+	params requestParams,
+	paginationMutator *func(*http.Request) error,
+) (*http.Request, error) {
+	if err := c.loadSelectedProfile(); err != nil {
+		return nil, err
+	}
+
+	// createRequest seeks params.Body back to the start, so it's safe to retry.
+	req, err := c.createRequest(ctx, method, endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
-		if retryAfter > 0 {
-			waitTime = retryAfter
+	if paginationMutator != nil {
+		if mutErr := (*paginationMutator)(req); mutErr != nil {
+			return nil, c.ErrorAndLogf("failed to mutate before request: %v", mutErr.Error())
 		}
+	}
+
+	if err = c.applyCredentials(req); err != nil {
+		return nil, err
+	}
+
+	if err = c.applyBeforeRequest(req); err != nil {
+		return nil, err
+	}
+
+	if c.debug && c.logger != nil {
+		req = c.logRequest(req)
+	}
+
+	return req, nil
+}
+
+// retryWaitTime determines the delay before the given retry attempt.
+// If the server provided a Retry-After duration it is used, otherwise the
+// client's BackoffStrategy is consulted. The result is clamped to the
+// configured minimum and maximum wait times.
+func (c *Client) retryWaitTime(resp *http.Response, attempt int, previous time.Duration) (time.Duration, error) {
+	retryAfter, err := c.retryAfter(resp)
+	if err != nil {
+		return 0, err
+	}
 
-		// Ensure the wait time is within the defined bounds
-		if waitTime < c.retryMinWaitTime {
-			waitTime = c.retryMinWaitTime
-		} else if waitTime > c.retryMaxWaitTime {
-			waitTime = c.retryMaxWaitTime
+	waitTime := retryAfter
+
+	if waitTime <= 0 {
+		backoff := c.retryBackoff
+		if backoff == nil {
+			backoff = ConstantBackoff
 		}
 
-		// Sleep for the calculated duration before retrying
-		time.Sleep(waitTime)
+		waitTime = backoff(attempt, previous, c.retryMinWaitTime, c.retryMaxWaitTime)
 	}
 
-	return err
+	// Ensure the wait time is within the defined bounds
+	if waitTime < c.retryMinWaitTime {
+		waitTime = c.retryMinWaitTime
+	} else if waitTime > c.retryMaxWaitTime {
+		waitTime = c.retryMaxWaitTime
+	}
+
+	return waitTime, nil
+}
+
+// sleepContext blocks for the given duration or until ctx is done,
+// whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (c *Client) shouldRetry(resp *http.Response, err error) bool {
@@ -643,6 +883,13 @@ func (c *Client) shouldRetry(resp *http.Response, err error) bool {
 func (c *Client) createRequest(ctx context.Context, method, endpoint string, params requestParams) (*http.Request, error) {
 	var bodyReader io.Reader
 
+	options := requestOptionsFromContext(ctx)
+
+	hostURL := c.hostURL
+	if options.apiVersion != "" {
+		hostURL = c.hostURLForVersion(options.apiVersion)
+	}
+
 	if params.Body != nil {
 		// Reset the body position to the start before using it
 		_, err := params.Body.Seek(0, io.SeekStart)
@@ -653,7 +900,7 @@ func (c *Client) createRequest(ctx context.Context, method, endpoint string, par
 		bodyReader = params.Body
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", strings.TrimRight(c.hostURL, "/"),
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", strings.TrimRight(hostURL, "/"),
 		strings.TrimLeft(endpoint, "/")), bodyReader)
 	if err != nil {
 		return nil, c.ErrorAndLogf("failed to create request: %v", err.Error())
@@ -674,6 +921,13 @@ func (c *Client) createRequest(ctx context.Context, method, endpoint string, par
 		}
 	}
 
+	// Apply headers from the request options
+	for name, values := range options.headers {
+		for _, value := range values {
+			req.Header.Set(name, value)
+		}
+	}
+
 	// Apply per-request headers (these take priority over client headers)
 	for name, values := range params.Headers {
 		for _, value := range values {
@@ -738,6 +992,11 @@ func (c *Client) logRequest(req *http.Request) *http.Request {
 		_ = c.ErrorAndLogf("failed to log request: %v", e.Error())
 	}
 
+	if sl, ok := c.logger.(*SlogLogger); ok {
+		sl.logRequest(req, reqLog.Headers, reqLog.Body)
+		return req
+	}
+
 	sanitizedBody := sanitizeLogValue(reqLog.Body)
 
 	body, jsonErr := formatBody(sanitizedBody)
@@ -856,6 +1115,13 @@ func (c *Client) logResponse(resp *http.Response, start, end time.Time) *http.Re
 		Body:         respBody.String(),
 	}
 
+	if sl, ok := c.logger.(*SlogLogger); ok {
+		sl.logResponse(resp, redactHeaders(respLog.Headers), respLog.Body, end.Sub(start))
+		resp.Body = io.NopCloser(bytes.NewReader(respBody.Bytes()))
+
+		return resp
+	}
+
 	body, jsonErr := formatBody(sanitizeLogValue(respLog.Body))
 	if jsonErr != nil {
 		if c.debug && c.logger != nil {
@@ -881,23 +1147,28 @@ func (c *Client) decodeResponseBody(resp *http.Response, response any) error {
 }
 
 func (c *Client) updateHostURL() {
+	c.hostURL = c.hostURLForVersion(c.apiVersion)
+}
+
+// hostURLForVersion returns the host URL of the client for the given API version,
+// falling back to the default version if it is empty.
+func (c *Client) hostURLForVersion(apiVersion string) string {
 	apiProto := APIProto
 	baseURL := APIHost
-	apiVersion := APIVersion
 
-	if c.baseURL != "" {
-		baseURL = c.baseURL
+	if apiVersion == "" {
+		apiVersion = APIVersion
 	}
 
-	if c.apiVersion != "" {
-		apiVersion = c.apiVersion
+	if c.baseURL != "" {
+		baseURL = c.baseURL
 	}
 
 	if c.apiProto != "" {
 		apiProto = c.apiProto
 	}
 
-	c.hostURL = strings.TrimRight(fmt.Sprintf("%s://%s/%s", apiProto, baseURL, url.PathEscape(apiVersion)), "/")
+	return strings.TrimRight(fmt.Sprintf("%s://%s/%s", apiProto, baseURL, url.PathEscape(apiVersion)), "/")
 }
 
 func (c *Client) tlsConfig() (*tls.Config, error) {
@@ -915,54 +1186,87 @@ func (c *Client) tlsConfig() (*tls.Config, error) {
 	return transport.TLSClientConfig, nil
 }
 
-func (c *Client) addCachedResponse(endpoint string, response any, expiry *time.Duration) {
-	if !c.shouldCache {
-		return
+// cacheKey returns the key the response of the given endpoint is cached under.
+// Keys are scoped to the API host and version (including a version set using WithAPIVersion)
+// and to a fingerprint of the client's token, so a cache shared between clients never serves
+// the responses of one account or environment to another. ok is false if the response should
+// not be cached, e.g. because the token of the client is not known yet.
+func (c *Client) cacheKey(ctx context.Context, endpoint string) (key string, ok bool) {
+	// The token of a profile selected using NewClientFromEnv is only applied by the first request
+	if c.loadedProfile != c.selectedProfile {
+		return "", false
 	}
 
-	responseValue := reflect.ValueOf(response)
+	options := requestOptionsFromContext(ctx)
 
-	entry := clientCacheEntry{
-		Created:        time.Now(),
-		ExpiryOverride: expiry,
+	// Request headers may change the response, so responses to them are not cached
+	if len(options.headers) > 0 {
+		return "", false
+	}
+
+	hostURL := c.hostURL
+	if options.apiVersion != "" {
+		hostURL = c.hostURLForVersion(options.apiVersion)
 	}
 
-	switch responseValue.Kind() {
-	case reflect.Pointer:
-		// We want to automatically deref pointers to
-		// avoid caching mutable data.
-		entry.Data = responseValue.Elem().Interface()
-	default:
-		entry.Data = response
+	authorization := c.header.Get("Authorization")
+
+	if c.credentialProvider != nil {
+		credentials, err := c.credentialProvider.Credentials(ctx)
+		if err != nil {
+			return "", false
+		}
+
+		authorization = "Bearer " + credentials.Token
 	}
 
-	c.cachedEntryLock.Lock()
-	defer c.cachedEntryLock.Unlock()
+	fingerprint := sha256.Sum256([]byte(authorization))
 
-	c.cachedEntries[endpoint] = entry
+	return fmt.Sprintf("%s %x %s", hostURL, fingerprint[:8], endpoint), true
 }
 
-func (c *Client) getCachedResponse(endpoint string) any {
+func (c *Client) addCachedResponse(ctx context.Context, endpoint string, response any, expiry *time.Duration) {
 	if !c.shouldCache {
-		return nil
+		return
 	}
 
-	c.cachedEntryLock.RLock()
+	key, ok := c.cacheKey(ctx, endpoint)
+	if !ok {
+		return
+	}
 
-	// Hacky logic to dynamically RUnlock
-	// only if it is still locked by the
-	// end of the function.
-	// This is necessary as we take write
-	// access if the entry has expired.
-	rLocked := true
+	// Responses are stored in their serialized form to avoid
+	// caching mutable data.
+	data, err := json.Marshal(response)
+	if err != nil {
+		_ = c.ErrorAndLogf("failed to marshal cached response: %v", err.Error())
+		return
+	}
 
-	defer func() {
-		if rLocked {
-			c.cachedEntryLock.RUnlock()
-		}
-	}()
+	entry := CacheEntry{
+		Created:        time.Now(),
+		Data:           data,
+		ExpiryOverride: expiry,
+	}
+
+	if err := c.cache.Set(key, entry); err != nil {
+		_ = c.ErrorAndLogf("failed to cache response: %v", err.Error())
+	}
+}
+
+// getCachedResponse returns the cached response for the given endpoint,
+// or nil if there is no valid cached response.
+func getCachedResponse[T any](ctx context.Context, c *Client, endpoint string) *T {
+	if !c.shouldCache || requestOptionsFromContext(ctx).skipCache {
+		return nil
+	}
+
+	key, ok := c.cacheKey(ctx, endpoint)
+	if !ok {
+		return nil
+	}
 
-	entry, ok := c.cachedEntries[endpoint]
+	entry, ok := c.cache.Get(key)
 	if !ok {
 		return nil
 	}
@@ -976,20 +1280,20 @@ func (c *Client) getCachedResponse(endpoint string) any {
 	}
 
 	if hasExpired {
-		// We need to give up our read access and request read-write access
-		c.cachedEntryLock.RUnlock()
-
-		rLocked = false
-
-		c.cachedEntryLock.Lock()
-		defer c.cachedEntryLock.Unlock()
+		if err := c.cache.Delete(key); err != nil {
+			_ = c.ErrorAndLogf("failed to delete expired cache entry: %v", err.Error())
+		}
 
-		delete(c.cachedEntries, endpoint)
+		return nil
+	}
 
+	var result T
+	if err := json.Unmarshal(entry.Data, &result); err != nil {
+		_ = c.ErrorAndLogf("failed to decode cached response: %v", err.Error())
 		return nil
 	}
 
-	return c.cachedEntries[endpoint].Data
+	return &result
 }
 
 func (c *Client) onRequestLog(rl func(*RequestLog) error) *Client {
@@ -1029,16 +1333,22 @@ func (c *Client) preLoadConfig(configPath string) error {
 		return err
 	}
 
-	// We don't want to load the profile until the user is actually making requests
-	c.OnBeforeRequest(func(_ *Request) error {
-		if c.loadedProfile != c.selectedProfile {
-			if err := c.UseProfile(c.selectedProfile); err != nil {
-				return err
-			}
-		}
+	// We don't want to load the profile until the user is actually making requests,
+	// see loadSelectedProfile
+	return nil
+}
 
+// loadSelectedProfile loads the profile selected by NewClientFromEnv if it has not been loaded yet.
+// It is called before each request rather than registered as a before-request hook, so it always
+// loads the profile into the client making the request.
+func (c *Client) loadSelectedProfile() error {
+	if c.loadedProfile == c.selectedProfile {
 		return nil
-	})
+	}
+
+	if err := c.UseProfile(c.selectedProfile); err != nil {
+		return c.ErrorAndLogf("failed to load profile %s: %w", c.selectedProfile, err)
+	}
 
 	return nil
 }
@@ -0,0 +1,111 @@
+package linodego
+
+import (
+	"maps"
+	"slices"
+)
+
+// ClientOption configures a client derived using Client.With.
+type ClientOption func(*Client) error
+
+// With returns a new client derived from c with the given options applied.
+//
+// The derived client has its own copy of the client's configuration, so configuring
+// it (e.g. using SetToken, SetHeader or UseProfile) does not affect c, and the two
+// clients can be used concurrently. The underlying http.Client, rate limiter and
+// response cache are shared with c, while event watches (see WatchEvents) are not.
+// Cached responses are scoped to the token, base URL and API version of the client
+// that requested them. Use WithIsolatedCache to give the derived client its own cache.
+//
+// Options are applied in order, after any profile selected using NewClientFromEnv is loaded.
+// An error is returned if the profile or any of the options cannot be applied.
+//
+//	customerClient, err := client.With(linodego.WithToken(customerToken))
+func (c *Client) With(opts ...ClientOption) (*Client, error) {
+	derived := *c
+
+	derived.header = c.header.Clone()
+	derived.configProfiles = maps.Clone(c.configProfiles)
+	derived.onBeforeRequest = slices.Clone(c.onBeforeRequest)
+	derived.onAfterResponse = slices.Clone(c.onAfterResponse)
+	derived.retryConditionals = slices.Clone(c.retryConditionals)
+	derived.requestObservers = slices.Clone(c.requestObservers)
+	derived.eventHub = newEventHub()
+
+	// Load a profile selected using NewClientFromEnv now, so requests made by the derived client
+	// do not modify it and the options take priority over the profile
+	if err := derived.loadSelectedProfile(); err != nil {
+		return nil, err
+	}
+
+	for _, opt := range opts {
+		if err := opt(&derived); err != nil {
+			return nil, err
+		}
+	}
+
+	return &derived, nil
+}
+
+// WithProfile loads the given profile of the client's config (see LoadConfig) into the derived client,
+// setting its token, base URL, API version and other profile settings.
+func WithProfile(name string) ClientOption {
+	return func(c *Client) error {
+		return c.UseProfile(name)
+	}
+}
+
+// WithToken sets the API token of the derived client.
+func WithToken(token string) ClientOption {
+	return func(c *Client) error {
+		c.SetToken(token)
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL of the derived client.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		c.SetBaseURL(baseURL)
+		return nil
+	}
+}
+
+// WithDefaultAPIVersion sets the API version used by the derived client.
+// Use WithAPIVersion to change the API version of individual requests.
+func WithDefaultAPIVersion(apiVersion string) ClientOption {
+	return func(c *Client) error {
+		c.SetAPIVersion(apiVersion)
+		return nil
+	}
+}
+
+// WithDefaultHeader sets a header sent with each request made by the derived client.
+// Use WithHeader to set headers on individual requests.
+func WithDefaultHeader(name, value string) ClientOption {
+	return func(c *Client) error {
+		c.SetHeader(name, value)
+		return nil
+	}
+}
+
+// WithUserAgent sets the user agent of the derived client.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.SetUserAgent(userAgent)
+		return nil
+	}
+}
+
+// WithIsolatedCache gives the derived client its own response cache
+// rather than sharing the cache of its parent.
+func WithIsolatedCache() ClientOption {
+	return func(c *Client) error {
+		c.cache = NewMemoryCache(MemoryCacheOptions{
+			MaxEntries: APIDefaultCacheMaxEntries,
+			MaxBytes:   APIDefaultCacheMaxBytes,
+		})
+
+		return nil
+	}
+}
@@ -0,0 +1,271 @@
+package linodego
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_With(t *testing.T) {
+	var regionRequests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if strings.HasSuffix(r.URL.Path, "/regions/us-east") {
+			regionRequests.Add(1)
+			_, _ = w.Write([]byte(`{"id": "us-east"}`))
+
+			return
+		}
+
+		// Echo the token and API version in the label of the instance
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		version := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")[0]
+
+		_, _ = fmt.Fprintf(w, `{"id": 1, "label": %q}`, token+" "+version+" "+r.Header.Get("X-Tenant"))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetToken("parent")
+
+	derived, err := client.With(WithToken("customer"), WithDefaultHeader("X-Tenant", "a"))
+	require.NoError(t, err)
+
+	beta, err := client.With(WithDefaultAPIVersion("v4beta"), WithIsolatedCache())
+	require.NoError(t, err)
+
+	derived.SetHeader("X-Other", "value")
+	require.Empty(t, client.header.Get("X-Other"))
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Go(func() {
+			instance, err := client.GetInstance(context.Background(), 1)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "parent v4 ", instance.Label)
+			}
+		})
+
+		wg.Go(func() {
+			instance, err := derived.GetInstance(context.Background(), 1)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "customer v4 a", instance.Label)
+			}
+		})
+
+		wg.Go(func() {
+			instance, err := beta.GetInstance(context.Background(), 1)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "parent v4beta ", instance.Label)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	// The response cache is shared between clients with the same token unless it is isolated
+	_, err = client.GetRegion(context.Background(), "us-east")
+	require.NoError(t, err)
+
+	tenant, err := client.With(WithDefaultHeader("X-Tenant", "b"))
+	require.NoError(t, err)
+
+	_, err = tenant.GetRegion(context.Background(), "us-east")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), regionRequests.Load())
+
+	_, err = derived.GetRegion(context.Background(), "us-east")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), regionRequests.Load())
+
+	_, err = beta.GetRegion(context.Background(), "us-east")
+	require.NoError(t, err)
+	require.Equal(t, int32(3), regionRequests.Load())
+}
+
+func TestClient_WithProfileFromEnv(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		_, _ = fmt.Fprintf(w, `{"id": 1, "label": %q}`, token)
+	}))
+	defer server.Close()
+
+	file := createTestConfig(t, fmt.Sprintf("[default]\ntoken = profile-token\napi_url = %s\n", server.URL))
+
+	t.Setenv(APIEnvVar, "")
+	t.Setenv(APIConfigEnvVar, file.Name())
+	t.Setenv(APIConfigProfileEnvVar, DefaultConfigProfile)
+
+	client, err := NewClientFromEnv(nil)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+
+	// Requests made by derived clients should not load the profile into the parent
+	for i := range 8 {
+		wg.Go(func() {
+			token := fmt.Sprintf("token-%d", i)
+
+			derived, err := client.With(WithToken(token))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			instance, err := derived.GetInstance(context.Background(), 1)
+			if assert.NoError(t, err) {
+				assert.Equal(t, token, instance.Label)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	require.Empty(t, client.loadedProfile)
+	require.Empty(t, client.header.Get("Authorization"))
+
+	instance, err := client.GetInstance(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "profile-token", instance.Label)
+	require.Equal(t, DefaultConfigProfile, client.loadedProfile)
+}
+
+func TestClient_WithProfile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		_, _ = fmt.Fprintf(w, `{"id": 1, "label": %q}`, token)
+	}))
+	defer server.Close()
+
+	file := createTestConfig(t, fmt.Sprintf(
+		"[default]\ntoken = default-token\napi_url = %[1]s\n\n[customer]\ntoken = customer-token\napi_url = %[1]s\n",
+		server.URL,
+	))
+
+	client := newTestClient(t, nil)
+	require.NoError(t, client.LoadConfig(&LoadConfigOptions{Path: file.Name(), Profile: DefaultConfigProfile}))
+
+	derived, err := client.With(WithProfile("customer"))
+	require.NoError(t, err)
+
+	instance, err := derived.GetInstance(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "customer-token", instance.Label)
+
+	// The parent keeps its own profile
+	instance, err = client.GetInstance(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "default-token", instance.Label)
+
+	// Options are applied after the profile
+	derived, err = client.With(WithProfile("customer"), WithToken("override"))
+	require.NoError(t, err)
+
+	instance, err = derived.GetInstance(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "override", instance.Label)
+
+	_, err = client.With(WithProfile("missing"))
+	require.ErrorContains(t, err, "profile missing does not exist")
+}
+
+func TestClient_WithProfileFromEnvError(t *testing.T) {
+	file := createTestConfig(t, "[default]\napi_url = https://api.example.com\n")
+
+	t.Setenv(APIEnvVar, "")
+	t.Setenv(APIConfigEnvVar, file.Name())
+	t.Setenv(APIConfigProfileEnvVar, DefaultConfigProfile)
+
+	client, err := NewClientFromEnv(nil)
+	require.NoError(t, err)
+
+	// A selected profile that cannot be loaded is reported when deriving a client
+	_, err = client.With(WithToken("token"))
+	require.ErrorContains(t, err, "unable to resolve linode_token")
+}
+
+func TestClient_WithProfileRootCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		_, _ = fmt.Fprintf(w, `{"id": 1, "label": %q}`, token)
+	}))
+	defer server.Close()
+
+	caPath := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caPath, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}), 0o600))
+
+	file := createTestConfig(t, fmt.Sprintf(
+		"[default]\ntoken = profile-token\napi_url = %s\nca_path = %s\n", server.URL, caPath,
+	))
+
+	t.Setenv(APIEnvVar, "")
+	t.Setenv(APIConfigEnvVar, file.Name())
+	t.Setenv(APIConfigProfileEnvVar, DefaultConfigProfile)
+
+	client, err := NewClientFromEnv(nil)
+	require.NoError(t, err)
+
+	parentTransport, err := client.Transport()
+	require.NoError(t, err)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		clients = make(map[*http.Client]bool)
+	)
+
+	// Derived clients trust the profile's root certificate without modifying the shared transport
+	for i := range 8 {
+		wg.Go(func() {
+			token := fmt.Sprintf("token-%d", i)
+
+			derived, err := client.With(WithToken(token))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			instance, err := derived.GetInstance(context.Background(), 1)
+			if assert.NoError(t, err) {
+				assert.Equal(t, token, instance.Label)
+			}
+
+			mu.Lock()
+			clients[derived.httpClient] = true
+			mu.Unlock()
+		})
+	}
+
+	wg.Wait()
+
+	if parentTransport.TLSClientConfig != nil {
+		require.Nil(t, parentTransport.TLSClientConfig.RootCAs)
+	}
+	require.Len(t, clients, 1, "the root certificate should only be applied once")
+
+	instance, err := client.GetInstance(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "profile-token", instance.Label)
+	require.True(t, clients[client.httpClient])
+}
@@ -2,17 +2,12 @@ package linodego
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"net/url"
 	"os"
-	"path"
-	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 )
 
 const (
@@ -29,230 +24,341 @@ const (
 )
 
 // MonitorClient is a wrapper around the http client
+//
+// Requests are made using the same transport as Client, so a MonitorClient supports
+// the same retries, backoff, rate limiting, request hooks and logging.
+// Like Client, failed requests are retried by default; use SetRetryCount(0) to disable retries.
 type MonitorClient struct {
-	httpClient  *http.Client
-	debug       bool
-	apiBaseURL  string
-	apiProtocol string
-	apiVersion  string
-	hostURL     string
-	userAgent   string
-	header      http.Header
-	logger      Logger
+	core *Client
 }
 
 // NewMonitorClient is the entry point for user to create a new MonitorClient
 // It utilizes default values and looks for environment variables to initialize a MonitorClient.
 func NewMonitorClient(hc *http.Client) (mClient MonitorClient) {
-	if hc != nil {
-		mClient.httpClient = hc
-	} else {
-		mClient.httpClient = &http.Client{}
+	core := newClient(hc)
+	mClient.core = &core
+
+	mClient.SetBaseURL(MonitorAPIHost)
+	mClient.SetAPIVersion(MonitorAPIVersion)
+	mClient.applyEnv()
+
+	return mClient
+}
+
+// NewMonitorClientFromEnv creates a MonitorClient and initializes it with values from the
+// MONITOR_API_TOKEN environment variable and the profile selected by the LINODE_CONFIG and
+// LINODE_PROFILE environment variables, if a config file exists.
+//
+// Profiles may set the monitor_api_url and monitor_api_version of the Monitor API, in addition to
+// the ca_path, retry_count and debug settings. Environment variables take priority over profiles.
+func NewMonitorClientFromEnv(hc *http.Client) (*MonitorClient, error) {
+	mClient := NewMonitorClient(hc)
+
+	configPath, err := resolveValidConfigPath()
+	if err != nil {
+		return nil, err
 	}
 
-	// Ensure transport is initialized so SetRootCertificate can configure TLS
-	if mClient.httpClient.Transport == nil {
-		mClient.httpClient.Transport = &http.Transport{}
+	if p, ok := os.LookupEnv(APIConfigEnvVar); ok {
+		configPath = p
 	}
 
-	mClient.header = make(http.Header)
-	mClient.logger = createLogger()
+	if configPath != "" {
+		profile := DefaultConfigProfile
+		if p, ok := os.LookupEnv(APIConfigProfileEnvVar); ok {
+			profile = p
+		}
 
-	mClient.SetUserAgent(DefaultUserAgent)
+		if err := mClient.LoadConfig(&LoadConfigOptions{Path: configPath, Profile: profile}); err != nil {
+			return nil, err
+		}
 
-	baseURL, baseURLExists := os.LookupEnv(MonitorAPIHostVar)
-	if baseURLExists {
-		mClient.SetBaseURL(baseURL)
-	} else {
-		mClient.SetBaseURL(MonitorAPIHost)
+		mClient.applyEnv()
 	}
 
-	apiVersion, apiVersionExists := os.LookupEnv(MonitorAPIVersionVar)
-	if apiVersionExists {
-		mClient.SetAPIVersion(apiVersion)
-	} else {
-		mClient.SetAPIVersion(MonitorAPIVersion)
+	if token, ok := os.LookupEnv(MonitorAPIEnvVar); !ok || token == "" {
+		return nil, fmt.Errorf("no monitor API token found, %s must be set", MonitorAPIEnvVar)
 	}
 
-	token, apiTokenExists := os.LookupEnv(MonitorAPIEnvVar)
-	if apiTokenExists {
-		mClient.SetToken(token)
+	return &mClient, nil
+}
+
+// NewMonitorClientForServiceType creates a MonitorClient for the given service type, authenticated
+// with a token created using CreateMonitorServiceTokenForServiceType.
+//
+// The MonitorClient shares the http.Client, logger, rate limiter, retry settings and request observers
+// of c, but not its request hooks or dry-run mode.
+// When the Monitor API rejects the token, a new token is created and the request is retried.
+func (c *Client) NewMonitorClientForServiceType(
+	ctx context.Context,
+	serviceType string,
+	opts MonitorTokenCreateOptions,
+) (*MonitorClient, error) {
+	parent, err := c.With()
+	if err != nil {
+		return nil, err
 	}
 
-	mClient.SetDebug(envDebug)
+	provider := &monitorServiceCredentialProvider{
+		parent:      parent,
+		serviceType: serviceType,
+		opts:        opts,
+	}
 
-	return mClient
+	// Create the first token so errors are reported immediately
+	if _, err := provider.Credentials(ctx); err != nil {
+		return nil, err
+	}
+
+	core, err := c.With(WithIsolatedCache())
+	if err != nil {
+		return nil, err
+	}
+
+	mClient := MonitorClient{core: core}
+
+	// Hooks and dry-run plans of c are meant for the requests of c, not the Monitor API
+	mClient.core.onBeforeRequest = nil
+	mClient.core.onAfterResponse = nil
+	mClient.core.dryRun = nil
+
+	mClient.SetBaseURL(MonitorAPIHost)
+	mClient.SetAPIVersion(MonitorAPIVersion)
+	mClient.applyEnv()
+
+	mClient.core.header.Del("Authorization")
+	mClient.core.SetCredentialProvider(provider)
+
+	return &mClient, nil
+}
+
+// monitorServiceCredentialProvider provides Monitor API tokens for a service type,
+// creating a new token using the parent client when the current token is rejected.
+type monitorServiceCredentialProvider struct {
+	parent      *Client
+	serviceType string
+	opts        MonitorTokenCreateOptions
+
+	mu          sync.Mutex
+	credentials *Credentials
+}
+
+var _ CredentialInvalidator = (*monitorServiceCredentialProvider)(nil)
+
+func (p *monitorServiceCredentialProvider) Credentials(ctx context.Context) (*Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.credentials != nil {
+		return p.credentials, nil
+	}
+
+	token, err := p.parent.CreateMonitorServiceTokenForServiceType(ctx, p.serviceType, p.opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create monitor token for service type %s: %w", p.serviceType, err)
+	}
+
+	p.credentials = &Credentials{Token: token.Token}
+
+	return p.credentials, nil
+}
+
+func (p *monitorServiceCredentialProvider) InvalidateCredentials() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.credentials = nil
+}
+
+// applyEnv applies the Monitor API environment variables to the client.
+func (mc *MonitorClient) applyEnv() {
+	if baseURL, ok := os.LookupEnv(MonitorAPIHostVar); ok {
+		mc.SetBaseURL(baseURL)
+	}
+
+	if apiVersion, ok := os.LookupEnv(MonitorAPIVersionVar); ok {
+		mc.SetAPIVersion(apiVersion)
+	}
+
+	if token, ok := os.LookupEnv(MonitorAPIEnvVar); ok {
+		mc.SetToken(token)
+	}
 }
 
 // SetUserAgent sets a custom user-agent for HTTP requests
 func (mc *MonitorClient) SetUserAgent(ua string) *MonitorClient {
-	mc.userAgent = ua
-	mc.header.Set("User-Agent", ua)
-
+	mc.core.SetUserAgent(ua)
 	return mc
 }
 
 // SetDebug sets the debug on the client
 func (mc *MonitorClient) SetDebug(debug bool) *MonitorClient {
-	mc.debug = debug
-
+	mc.core.SetDebug(debug)
 	return mc
 }
 
 // SetLogger allows the user to override the output
 // logger for debug logs.
 func (mc *MonitorClient) SetLogger(logger Logger) *MonitorClient {
-	mc.logger = logger
-
+	mc.core.SetLogger(logger)
 	return mc
 }
 
 // SetBaseURL is the helper function to set base url
 func (mc *MonitorClient) SetBaseURL(baseURL string) *MonitorClient {
-	baseURLPath, _ := url.Parse(baseURL)
-
-	mc.apiBaseURL = path.Join(baseURLPath.Host, baseURLPath.Path)
-	mc.apiProtocol = baseURLPath.Scheme
-
-	mc.updateMonitorHostURL()
-
+	mc.core.SetBaseURL(baseURL)
 	return mc
 }
 
 // SetAPIVersion is the helper function to set api version
 func (mc *MonitorClient) SetAPIVersion(apiVersion string) *MonitorClient {
-	mc.apiVersion = apiVersion
-
-	mc.updateMonitorHostURL()
-
+	mc.core.SetAPIVersion(apiVersion)
 	return mc
 }
 
 // SetRootCertificate adds a root certificate to the underlying TLS client config.
 func (mc *MonitorClient) SetRootCertificate(certPath string) error {
-	transport, ok := mc.httpClient.Transport.(*http.Transport)
-	if !ok {
-		err := fmt.Errorf("current transport is not an *http.Transport instance")
-		if mc.logger != nil {
-			mc.logger.Errorf("%s", err)
-		}
-
-		return err
-	}
-
-	if transport.TLSClientConfig == nil {
-		transport.TLSClientConfig = &tls.Config{
-			MinVersion: tls.VersionTLS12,
-		}
-	}
-
-	if transport.TLSClientConfig.RootCAs == nil {
-		transport.TLSClientConfig.RootCAs = x509.NewCertPool()
-	}
-
-	pem, err := os.ReadFile(filepath.Clean(certPath))
-	if err != nil {
-		if mc.logger != nil {
-			mc.logger.Errorf("Failed to read root certificate at %s: %s", certPath, err.Error())
-		}
-
-		return fmt.Errorf("failed to read root certificate at %s: %w", certPath, err)
-	}
-
-	transport.TLSClientConfig.RootCAs.AppendCertsFromPEM(pem)
-
-	return nil
+	return mc.core.SetRootCertificate(certPath)
 }
 
 // SetToken sets the API token for all requests from this client
 func (mc *MonitorClient) SetToken(token string) *MonitorClient {
-	mc.header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	mc.core.SetToken(token)
+	return mc
+}
+
+// SetCredentialProvider sets the provider of the credentials used to authenticate requests.
+func (mc *MonitorClient) SetCredentialProvider(provider CredentialProvider) *MonitorClient {
+	mc.core.SetCredentialProvider(provider)
 	return mc
 }
 
 // SetHeader sets a custom header to be used in all API requests made with the current client.
 // NOTE: Some headers may be overridden by the individual request functions.
 func (mc *MonitorClient) SetHeader(name, value string) {
-	mc.header.Set(name, value)
+	mc.core.SetHeader(name, value)
 }
 
-func (mc *MonitorClient) updateMonitorHostURL() {
-	apiProto := APIProto
-	baseURL := MonitorAPIHost
-	apiVersion := MonitorAPIVersion
+// OnBeforeRequest adds a handler to the request body to run before the request is sent
+func (mc *MonitorClient) OnBeforeRequest(m func(*http.Request) error) {
+	mc.core.OnBeforeRequest(m)
+}
 
-	if mc.apiBaseURL != "" {
-		baseURL = mc.apiBaseURL
-	}
+// OnAfterResponse adds a handler to the request body to run before the request is sent
+func (mc *MonitorClient) OnAfterResponse(m func(*http.Response) error) {
+	mc.core.OnAfterResponse(m)
+}
 
-	if mc.apiVersion != "" {
-		apiVersion = mc.apiVersion
-	}
+// AddRequestObserver adds an observer that is notified of each request made by the client.
+func (mc *MonitorClient) AddRequestObserver(observer RequestObserver) *MonitorClient {
+	mc.core.AddRequestObserver(observer)
+	return mc
+}
 
-	if mc.apiProtocol != "" {
-		apiProto = mc.apiProtocol
-	}
+// AddRetryCondition adds a RetryConditional function to the Client
+func (mc *MonitorClient) AddRetryCondition(retryCondition RetryConditional) *MonitorClient {
+	mc.core.AddRetryCondition(retryCondition)
+	return mc
+}
 
-	mc.hostURL = fmt.Sprintf(
-		"%s://%s/%s",
-		apiProto,
-		baseURL,
-		url.PathEscape(apiVersion),
-	)
+// SetRetryCount sets the maximum retry attempts before aborting.
+func (mc *MonitorClient) SetRetryCount(count int) *MonitorClient {
+	mc.core.SetRetryCount(count)
+	return mc
 }
 
-// doRequest is a generic helper to execute HTTP requests for the MonitorClient
-func (mc *MonitorClient) doRequest(ctx context.Context, method, endpoint string, params requestParams) error {
-	var bodyReader io.Reader
+// SetRetryWaitTime sets the default (minimum) delay before retrying a request.
+func (mc *MonitorClient) SetRetryWaitTime(minWaitTime time.Duration) *MonitorClient {
+	mc.core.SetRetryWaitTime(minWaitTime)
+	return mc
+}
 
-	if params.Body != nil {
-		if _, err := params.Body.Seek(0, io.SeekStart); err != nil {
-			return fmt.Errorf("failed to seek body: %w", err)
-		}
+// SetRetryMaxWaitTime sets the maximum delay before retrying a request.
+func (mc *MonitorClient) SetRetryMaxWaitTime(maxWaitTime time.Duration) *MonitorClient {
+	mc.core.SetRetryMaxWaitTime(maxWaitTime)
+	return mc
+}
 
-		bodyReader = params.Body
-	}
+// SetBackoffStrategy sets the strategy used to determine the delay between retries.
+func (mc *MonitorClient) SetBackoffStrategy(strategy BackoffStrategy) *MonitorClient {
+	mc.core.SetBackoffStrategy(strategy)
+	return mc
+}
 
-	reqURL := fmt.Sprintf("%s/%s", strings.TrimRight(mc.hostURL, "/"), strings.TrimLeft(endpoint, "/"))
+// SetRateLimiter sets the rate limiter used to throttle requests.
+func (mc *MonitorClient) SetRateLimiter(limiter *RateLimiter) *MonitorClient {
+	mc.core.SetRateLimiter(limiter)
+	return mc
+}
 
-	req, err := http.NewRequestWithContext(ctx, method, reqURL, bodyReader)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
+// UseCache sets whether response caching should be used
+func (mc *MonitorClient) UseCache(value bool) {
+	mc.core.UseCache(value)
+}
+
+// SetResponseCache sets the cache used to store responses.
+func (mc *MonitorClient) SetResponseCache(cache ResponseCache) *MonitorClient {
+	mc.core.SetResponseCache(cache)
+	return mc
+}
+
+// InvalidateCache clears all cached responses for all endpoints.
+func (mc *MonitorClient) InvalidateCache() {
+	mc.core.InvalidateCache()
+}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+// LoadConfig loads a Linode config according to the option's argument, and applies the
+// Monitor API settings of the selected profile (see UseProfile).
+func (mc *MonitorClient) LoadConfig(options *LoadConfigOptions) error {
+	loadOptions := LoadConfigOptions{SkipLoadProfile: true}
+	profile := DefaultConfigProfile
 
-	for name, values := range mc.header {
-		for _, value := range values {
-			req.Header.Set(name, value)
+	if options != nil {
+		loadOptions.Path = options.Path
+
+		if options.Profile != "" {
+			profile = options.Profile
 		}
 	}
 
-	if mc.debug && mc.logger != nil {
-		mc.logger.Debugf("Sending request: %s %s", method, reqURL)
+	if err := mc.core.LoadConfig(&loadOptions); err != nil {
+		return err
 	}
 
-	resp, err := mc.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %w", err)
+	if options != nil && options.SkipLoadProfile {
+		return nil
 	}
-	defer resp.Body.Close()
 
-	_, err = coupleAPIErrors(resp, nil)
-	if err != nil {
+	return mc.UseProfile(profile)
+}
+
+// UseProfile applies the Monitor API settings of the given profile from the loaded config:
+// monitor_api_url, monitor_api_version, ca_path, retry_count and debug.
+// The token of the profile is not used, since Monitor API tokens are created per service type.
+func (mc *MonitorClient) UseProfile(name string) error {
+	name = strings.ToLower(name)
+
+	profile, ok := mc.core.configProfiles[name]
+	if !ok {
+		return fmt.Errorf("profile %s does not exist", name)
+	}
+
+	if err := mc.core.applyProfileSettings(name, profile); err != nil {
 		return err
 	}
 
-	if mc.debug && mc.logger != nil {
-		mc.logger.Debugf("Received response: %s", resp.Status)
+	if profile.MonitorAPIURL != "" {
+		mc.SetBaseURL(profile.MonitorAPIURL)
 	}
 
-	if params.Response != nil {
-		if err := json.NewDecoder(resp.Body).Decode(params.Response); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
-		}
+	if profile.MonitorAPIVersion != "" {
+		mc.SetAPIVersion(profile.MonitorAPIVersion)
 	}
 
 	return nil
 }
+
+// doRequest is a generic helper to execute HTTP requests for the MonitorClient
+func (mc *MonitorClient) doRequest(ctx context.Context, method, endpoint string, params requestParams) error {
+	return mc.core.doRequest(ctx, method, endpoint, params, nil)
+}
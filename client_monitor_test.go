@@ -0,0 +1,156 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorClient_SharedTransport(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch requests.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Too Many Requests"}]}`))
+
+			return
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Service Unavailable"}]}`))
+
+			return
+		}
+
+		require.Equal(t, "/v2beta/monitor/services/dbaas/metrics", r.URL.Path)
+		require.Equal(t, "true", r.Header.Get("X-Before-Request"))
+
+		_, _ = w.Write([]byte(`{"status": "success", "isPartial": false}`))
+	}))
+	defer server.Close()
+
+	var responses atomic.Int32
+
+	client := NewMonitorClient(nil)
+	client.SetBaseURL(server.URL).SetToken("token").SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond)
+
+	client.OnBeforeRequest(func(r *http.Request) error {
+		r.Header.Set("X-Before-Request", "true")
+		return nil
+	})
+	client.OnAfterResponse(func(*http.Response) error {
+		responses.Add(1)
+		return nil
+	})
+
+	// Rate limited and unavailable responses are retried like those of Client
+	metrics, err := client.FetchEntityMetrics(context.Background(), "dbaas", &EntityMetricsFetchOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "success", metrics.Status)
+	require.Equal(t, int32(3), requests.Load())
+	require.Equal(t, int32(1), responses.Load())
+
+	// Retries can be disabled
+	requests.Store(0)
+	client.SetRetryCount(0)
+
+	_, err = client.FetchEntityMetrics(context.Background(), "dbaas", &EntityMetricsFetchOptions{})
+	require.True(t, ErrHasStatus(err, http.StatusTooManyRequests))
+	require.Equal(t, int32(1), requests.Load())
+}
+
+func TestMonitorClient_FromEnv(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "linode")
+	require.NoError(t, os.WriteFile(configPath, []byte(`[default]
+token = linode-token
+monitor_api_url = https://monitor.cool.linode.com
+monitor_api_version = v2
+retry_count = 3
+`), 0o600))
+
+	t.Setenv(APIConfigEnvVar, configPath)
+	t.Setenv(MonitorAPIEnvVar, "")
+
+	_, err := NewMonitorClientFromEnv(nil)
+	require.ErrorContains(t, err, MonitorAPIEnvVar)
+
+	t.Setenv(MonitorAPIEnvVar, "monitor-token")
+
+	client, err := NewMonitorClientFromEnv(nil)
+	require.NoError(t, err)
+	require.Equal(t, "https://monitor.cool.linode.com/v2", client.core.hostURL)
+	require.Equal(t, "Bearer monitor-token", client.core.header.Get("Authorization"))
+	require.Equal(t, 3, client.core.retryCount)
+
+	// Environment variables take priority over the profile
+	t.Setenv(MonitorAPIVersionVar, "v2beta")
+
+	client, err = NewMonitorClientFromEnv(nil)
+	require.NoError(t, err)
+	require.Equal(t, "https://monitor.cool.linode.com/v2beta", client.core.hostURL)
+}
+
+func TestClient_NewMonitorClientForServiceType(t *testing.T) {
+	var tokens atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/monitor/services/dbaas/token":
+			require.Equal(t, "Bearer linode-token", r.Header.Get("Authorization"))
+
+			if tokens.Add(1) == 1 {
+				_, _ = w.Write([]byte(`{"token": "expired"}`))
+			} else {
+				_, _ = w.Write([]byte(`{"token": "fresh"}`))
+			}
+		case "/v2beta/monitor/services/dbaas/metrics":
+			if r.Header.Get("Authorization") != "Bearer fresh" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"errors": [{"reason": "Invalid Token"}]}`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"status": "success"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetToken("linode-token")
+	client.OnBeforeRequest(func(r *http.Request) error {
+		require.NotContains(t, r.URL.Path, "/metrics", "hooks of the parent client should not apply to the monitor client")
+		return nil
+	})
+
+	monitorClient, err := client.NewMonitorClientForServiceType(context.Background(), "dbaas", MonitorTokenCreateOptions{
+		EntityIDs: []any{1, 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), tokens.Load())
+
+	monitorClient.SetBaseURL(server.URL)
+
+	// The rejected token is replaced and the request is retried
+	metrics, err := monitorClient.FetchEntityMetrics(context.Background(), "dbaas", nil)
+	require.NoError(t, err)
+	require.Equal(t, "success", metrics.Status)
+	require.Equal(t, int32(2), tokens.Load())
+
+	// The parent client is not affected
+	require.Equal(t, "Bearer linode-token", client.header.Get("Authorization"))
+}
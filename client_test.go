@@ -667,39 +667,39 @@ func TestMonitorClient_SetAPIBasics(t *testing.T) {
 
 	client := NewMonitorClient(nil)
 
-	if client.hostURL != defaultURL {
-		t.Fatal(cmp.Diff(client.hostURL, defaultURL))
+	if client.core.hostURL != defaultURL {
+		t.Fatal(cmp.Diff(client.core.hostURL, defaultURL))
 	}
 
 	client.SetBaseURL(baseURL)
 	client.SetAPIVersion(apiVersion)
 
-	if client.hostURL != expectedHost {
-		t.Fatal(cmp.Diff(client.hostURL, expectedHost))
+	if client.core.hostURL != expectedHost {
+		t.Fatal(cmp.Diff(client.core.hostURL, expectedHost))
 	}
 
 	// Ensure setting twice does not cause conflicts
 	client.SetBaseURL(updatedBaseURL)
 	client.SetAPIVersion(updatedAPIVersion)
 
-	if client.hostURL != updatedExpectedHost {
-		t.Fatal(cmp.Diff(client.hostURL, updatedExpectedHost))
+	if client.core.hostURL != updatedExpectedHost {
+		t.Fatal(cmp.Diff(client.core.hostURL, updatedExpectedHost))
 	}
 
 	// Revert
 	client.SetBaseURL(baseURL)
 	client.SetAPIVersion(apiVersion)
 
-	if client.hostURL != expectedHost {
-		t.Fatal(cmp.Diff(client.hostURL, expectedHost))
+	if client.core.hostURL != expectedHost {
+		t.Fatal(cmp.Diff(client.core.hostURL, expectedHost))
 	}
 
 	// Custom protocol
 	client.SetBaseURL(protocolBaseURL)
 	client.SetAPIVersion(protocolAPIVersion)
 
-	if client.hostURL != protocolExpectedHost {
-		t.Fatal(cmp.Diff(client.hostURL, expectedHost))
+	if client.core.hostURL != protocolExpectedHost {
+		t.Fatal(cmp.Diff(client.core.hostURL, expectedHost))
 	}
 }
 
@@ -751,7 +751,7 @@ func TestMonitorClient_SetRootCertificateWithoutCustomRoundTripper(t *testing.T)
 			err := client.SetRootCertificate(caFile.Name())
 			require.NoError(t, err)
 
-			transport, ok := client.httpClient.Transport.(*http.Transport)
+			transport, ok := client.core.httpClient.Transport.(*http.Transport)
 			if !ok {
 				t.Fatal("expected *http.Transport")
 			}
@@ -890,3 +890,89 @@ func TestDoRequest_RetryCountZero_StillExecutes(t *testing.T) {
 	require.True(t, called.Load(), "server handler should have been called even with retryCount=0")
 	require.Equal(t, 1, got.ID, "response should have been decoded")
 }
+
+func TestDoRequest_RetryAbortsOnContextCancel(t *testing.T) {
+	var calls atomic.Int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL)
+	client.SetRetryWaitTime(time.Minute)
+	client.SetRetryMaxWaitTime(time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := client.doRequest(ctx, http.MethodGet, "/test", requestParams{}, nil)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 10*time.Second, "retry wait should be interrupted by the context")
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestDoRequest_RetryMaxElapsedTime(t *testing.T) {
+	var calls atomic.Int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL)
+	client.SetRetryWaitTime(10 * time.Millisecond)
+	client.SetRetryMaxWaitTime(10 * time.Millisecond)
+	client.SetRetryMaxElapsedTime(35 * time.Millisecond)
+
+	err := client.doRequest(context.Background(), http.MethodGet, "/test", requestParams{}, nil)
+	require.True(t, ErrHasStatus(err, http.StatusTooManyRequests))
+
+	// The budget allows a handful of retries but not the default 1000.
+	require.Greater(t, calls.Load(), int32(1))
+	require.Less(t, calls.Load(), int32(10))
+}
+
+func TestDoRequest_BackoffStrategy(t *testing.T) {
+	var calls atomic.Int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 4 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+	client.SetRetryMaxWaitTime(time.Second)
+
+	var attempts []int
+
+	client.SetBackoffStrategy(func(attempt int, previous, minWait, maxWait time.Duration) time.Duration {
+		attempts = append(attempts, attempt)
+		return minWait
+	})
+
+	err := client.doRequest(context.Background(), http.MethodGet, "/test", requestParams{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, attempts)
+}
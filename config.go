@@ -1,9 +1,14 @@
 package linodego
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 
 	"gopkg.in/ini.v1"
 )
@@ -21,8 +26,24 @@ type ConfigProfile struct {
 	APIToken   string `ini:"token"`
 	APIVersion string `ini:"api_version"`
 	APIURL     string `ini:"api_url"`
+
+	// CAPath is the path of a root certificate used to verify the API's certificate.
+	CAPath string `ini:"ca_path"`
+	// RetryCount overrides the number of times failed requests are retried.
+	RetryCount *int `ini:"retry_count"`
+	// Debug overrides whether debug logging is enabled.
+	Debug *bool `ini:"debug"`
+
+	// MonitorAPIURL is the URL of the Monitor API used by MonitorClient.
+	MonitorAPIURL string `ini:"monitor_api_url"`
+	// MonitorAPIVersion is the version of the Monitor API used by MonitorClient.
+	MonitorAPIVersion string `ini:"monitor_api_version"`
 }
 
+// configDefaultUserKey is the key of the DEFAULT section used by linode-cli
+// to store the name of the default profile.
+const configDefaultUserKey = "default-user"
+
 type LoadConfigOptions struct {
 	Path            string
 	Profile         string
@@ -75,6 +96,11 @@ func (c *Client) LoadConfig(options *LoadConfigOptions) error {
 		name := strings.ToLower(profile.Name())
 
 		f := defaultConfig
+
+		// Copy the pointer fields so values are not shared between profiles
+		f.RetryCount = copyInt(f.RetryCount)
+		f.Debug = copyBool(f.Debug)
+
 		if err := profile.MapTo(&f); err != nil {
 			return fmt.Errorf("failed to map values: %w", err)
 		}
@@ -82,6 +108,13 @@ func (c *Client) LoadConfig(options *LoadConfigOptions) error {
 		result[name] = f
 	}
 
+	// Profiles written by linode-cli name the default profile in the DEFAULT section
+	if defaultUser := cfg.Section(ini.DefaultSection).Key(configDefaultUserKey).String(); defaultUser != "" {
+		if profile, ok := result[strings.ToLower(defaultUser)]; ok && result[DefaultConfigProfile].APIToken == "" {
+			result[DefaultConfigProfile] = profile
+		}
+	}
+
 	c.configProfiles = result
 
 	if !options.SkipLoadProfile {
@@ -115,6 +148,10 @@ func (c *Client) UseProfile(name string) error {
 		return fmt.Errorf("unable to resolve linode_api_version for profile %s", name)
 	}
 
+	if err := c.applyProfileSettings(name, profile); err != nil {
+		return err
+	}
+
 	c.SetToken(profile.APIToken)
 	c.SetBaseURL(profile.APIURL)
 	c.SetAPIVersion(profile.APIVersion)
@@ -124,6 +161,107 @@ func (c *Client) UseProfile(name string) error {
 	return nil
 }
 
+// applyProfileSettings applies the optional client settings of a profile.
+func (c *Client) applyProfileSettings(name string, profile ConfigProfile) error {
+	if profile.CAPath != "" {
+		httpClient, err := c.profileHTTPClients.get(c.httpClient, profile.CAPath)
+		if err != nil {
+			return fmt.Errorf("unable to use ca_path for profile %s: %w", name, err)
+		}
+
+		c.httpClient = httpClient
+	}
+
+	if profile.RetryCount != nil {
+		c.SetRetryCount(*profile.RetryCount)
+	}
+
+	if profile.Debug != nil {
+		c.SetDebug(*profile.Debug)
+	}
+
+	return nil
+}
+
+// profileHTTPClients caches the HTTP clients created for the root certificates of profiles.
+// The transport of the base client is shared with other clients (e.g. clients derived using
+// Client.With), so it is cloned rather than modified.
+type profileHTTPClients struct {
+	mu      sync.Mutex
+	clients map[profileHTTPClientKey]*http.Client
+}
+
+type profileHTTPClientKey struct {
+	base   *http.Client
+	caPath string
+}
+
+// get returns a copy of base that also trusts the root certificate at caPath,
+// creating it on the first call for base and caPath.
+func (p *profileHTTPClients) get(base *http.Client, caPath string) (*http.Client, error) {
+	if p == nil {
+		return newRootCertificateHTTPClient(base, caPath)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := profileHTTPClientKey{base: base, caPath: caPath}
+
+	if client, ok := p.clients[key]; ok {
+		return client, nil
+	}
+
+	client, err := newRootCertificateHTTPClient(base, caPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.clients == nil {
+		p.clients = make(map[profileHTTPClientKey]*http.Client)
+	}
+
+	p.clients[key] = client
+
+	return client, nil
+}
+
+// newRootCertificateHTTPClient returns a copy of base with a cloned transport
+// that also trusts the root certificate at caPath.
+func newRootCertificateHTTPClient(base *http.Client, caPath string) (*http.Client, error) {
+	transport, ok := base.Transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("custom transport is not allowed with a custom root CA")
+	}
+
+	pem, err := os.ReadFile(filepath.Clean(caPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read root certificate at %s: %w", caPath, err)
+	}
+
+	transport = transport.Clone()
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	// The certificate pool is not cloned along with the TLS config
+	if roots := transport.TLSClientConfig.RootCAs; roots != nil {
+		transport.TLSClientConfig.RootCAs = roots.Clone()
+	} else {
+		transport.TLSClientConfig.RootCAs = x509.NewCertPool()
+	}
+
+	transport.TLSClientConfig.RootCAs.AppendCertsFromPEM(pem)
+
+	client := *base
+	client.Transport = transport
+
+	return &client, nil
+}
+
 func FormatConfigPath(path string) (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -0,0 +1,281 @@
+package linodego
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/ini.v1"
+)
+
+// configProfileKeys are the keys of a profile that map to ConfigProfile fields.
+var configProfileKeys = []string{
+	"token", "api_version", "api_url", "ca_path", "retry_count", "debug", "monitor_api_url", "monitor_api_version",
+}
+
+// ConfigFile is a Linode config file in the INI format used by linode-cli,
+// which can be read by LoadConfig and NewClientFromEnv.
+//
+// Profiles can be created, updated and deleted, and the default profile can be set,
+// in the same way as `linode-cli configure`. Comments and keys that are not managed
+// by ConfigFile are preserved when the file is saved.
+type ConfigFile struct {
+	// Path is the path the config file is saved to.
+	Path string
+
+	file *ini.File
+}
+
+// ConfigFileProfile is a profile in a ConfigFile.
+type ConfigFileProfile struct {
+	ConfigProfile
+
+	// Settings are the other keys of the profile, such as the
+	// linode-cli defaults for new resources (e.g. region, type and image).
+	Settings map[string]string
+}
+
+// LoadConfigFile loads the config file at the given path.
+// If path is empty, the first of DefaultConfigPaths that exists is used,
+// falling back to the first of DefaultConfigPaths.
+// A config file that does not exist yet is treated as empty.
+func LoadConfigFile(path string) (*ConfigFile, error) {
+	if path == "" {
+		var err error
+		if path, err = resolveValidConfigPath(); err != nil {
+			return nil, err
+		}
+	}
+
+	if path == "" {
+		var err error
+		if path, err = FormatConfigPath(DefaultConfigPaths[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := ini.LooseLoad(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config file %s: %w", path, err)
+	}
+
+	return &ConfigFile{Path: path, file: file}, nil
+}
+
+// Save writes the config file to its path, creating the parent directory if needed.
+func (f *ConfigFile) Save() error {
+	if err := os.MkdirAll(filepath.Dir(f.Path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	// The config file contains API tokens, so it should only be readable by the user
+	file, err := os.OpenFile(filepath.Clean(f.Path), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to save config file %s: %w", f.Path, err)
+	}
+
+	if _, err := f.file.WriteTo(file); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to save config file %s: %w", f.Path, err)
+	}
+
+	return file.Close()
+}
+
+// Profiles returns the names of the profiles in the config file, in the order they appear.
+func (f *ConfigFile) Profiles() []string {
+	names := f.file.SectionStrings()
+	profiles := make([]string, 0, len(names))
+
+	for _, name := range names {
+		if name != ini.DefaultSection {
+			profiles = append(profiles, name)
+		}
+	}
+
+	return profiles
+}
+
+// Profile returns the profile with the given name.
+// Profile names are case-insensitive.
+func (f *ConfigFile) Profile(name string) (*ConfigFileProfile, error) {
+	section := f.section(name)
+	if section == nil {
+		return nil, fmt.Errorf("profile %s does not exist", name)
+	}
+
+	profile := ConfigFileProfile{
+		ConfigProfile: ConfigProfile{
+			APIToken:   configKeyString(section, "token"),
+			APIVersion: configKeyString(section, "api_version"),
+			APIURL:     configKeyString(section, "api_url"),
+			CAPath:     configKeyString(section, "ca_path"),
+
+			MonitorAPIURL:     configKeyString(section, "monitor_api_url"),
+			MonitorAPIVersion: configKeyString(section, "monitor_api_version"),
+		},
+		Settings: make(map[string]string),
+	}
+
+	if section.HasKey("retry_count") {
+		retryCount, err := section.Key("retry_count").Int()
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry_count for profile %s: %w", name, err)
+		}
+
+		profile.RetryCount = &retryCount
+	}
+
+	if section.HasKey("debug") {
+		debug, err := section.Key("debug").Bool()
+		if err != nil {
+			return nil, fmt.Errorf("invalid debug for profile %s: %w", name, err)
+		}
+
+		profile.Debug = &debug
+	}
+
+	for _, key := range section.Keys() {
+		if !isConfigProfileKey(key.Name()) {
+			profile.Settings[key.Name()] = key.String()
+		}
+	}
+
+	return &profile, nil
+}
+
+// SetProfile creates or updates the profile with the given name.
+//
+// Empty ConfigProfile fields are removed from the profile, while Settings are added to
+// the profile without removing any other keys. Use DeleteProfileSetting to remove a setting.
+func (f *ConfigFile) SetProfile(name string, profile ConfigFileProfile) error {
+	if name == "" || strings.EqualFold(name, ini.DefaultSection) {
+		return fmt.Errorf("invalid profile name %q", name)
+	}
+
+	for key := range profile.Settings {
+		if isConfigProfileKey(key) {
+			return fmt.Errorf("setting %s must be set using the ConfigProfile fields", key)
+		}
+	}
+
+	section := f.section(name)
+	if section == nil {
+		var err error
+		if section, err = f.file.NewSection(name); err != nil {
+			return fmt.Errorf("failed to create profile %s: %w", name, err)
+		}
+	}
+
+	setConfigKey(section, "token", profile.APIToken)
+	setConfigKey(section, "api_version", profile.APIVersion)
+	setConfigKey(section, "api_url", profile.APIURL)
+	setConfigKey(section, "ca_path", profile.CAPath)
+	setConfigKey(section, "monitor_api_url", profile.MonitorAPIURL)
+	setConfigKey(section, "monitor_api_version", profile.MonitorAPIVersion)
+
+	retryCount := ""
+	if profile.RetryCount != nil {
+		retryCount = strconv.Itoa(*profile.RetryCount)
+	}
+
+	setConfigKey(section, "retry_count", retryCount)
+
+	debug := ""
+	if profile.Debug != nil {
+		debug = strconv.FormatBool(*profile.Debug)
+	}
+
+	setConfigKey(section, "debug", debug)
+
+	for key, value := range profile.Settings {
+		section.Key(key).SetValue(value)
+	}
+
+	return nil
+}
+
+// DeleteProfileSetting removes a setting from the profile with the given name.
+func (f *ConfigFile) DeleteProfileSetting(name, key string) error {
+	section := f.section(name)
+	if section == nil {
+		return fmt.Errorf("profile %s does not exist", name)
+	}
+
+	section.DeleteKey(key)
+
+	return nil
+}
+
+// DeleteProfile deletes the profile with the given name.
+// If it is the default profile, the config file is left without a default profile.
+func (f *ConfigFile) DeleteProfile(name string) error {
+	section := f.section(name)
+	if section == nil {
+		return fmt.Errorf("profile %s does not exist", name)
+	}
+
+	if strings.EqualFold(f.DefaultProfile(), section.Name()) {
+		f.file.Section(ini.DefaultSection).DeleteKey(configDefaultUserKey)
+	}
+
+	f.file.DeleteSection(section.Name())
+
+	return nil
+}
+
+// DefaultProfile returns the name of the default profile,
+// or an empty string if no default profile is set.
+func (f *ConfigFile) DefaultProfile() string {
+	return configKeyString(f.file.Section(ini.DefaultSection), configDefaultUserKey)
+}
+
+// SetDefaultProfile sets the profile used when no profile is selected.
+// The profile must exist.
+func (f *ConfigFile) SetDefaultProfile(name string) error {
+	section := f.section(name)
+	if section == nil {
+		return fmt.Errorf("profile %s does not exist", name)
+	}
+
+	f.file.Section(ini.DefaultSection).Key(configDefaultUserKey).SetValue(section.Name())
+
+	return nil
+}
+
+// section returns the section of the profile with the given name, ignoring case,
+// or nil if the profile does not exist.
+func (f *ConfigFile) section(name string) *ini.Section {
+	for _, section := range f.file.Sections() {
+		if section.Name() != ini.DefaultSection && strings.EqualFold(section.Name(), name) {
+			return section
+		}
+	}
+
+	return nil
+}
+
+// configKeyString returns the value of the given key without creating the key if it does not exist.
+func configKeyString(section *ini.Section, key string) string {
+	if !section.HasKey(key) {
+		return ""
+	}
+
+	return section.Key(key).String()
+}
+
+func setConfigKey(section *ini.Section, key, value string) {
+	if value == "" {
+		section.DeleteKey(key)
+		return
+	}
+
+	section.Key(key).SetValue(value)
+}
+
+func isConfigProfileKey(key string) bool {
+	return slices.Contains(configProfileKeys, key)
+}
@@ -0,0 +1,115 @@
+package linodego
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const configLinodeCLI = `[DEFAULT]
+default-user = alice
+
+# Alice's account
+[alice]
+token = alice-token
+region = us-east
+type = g6-nanode-1
+
+[bob]
+token = bob-token
+api_version = v4beta
+retry_count = 5
+debug = false
+`
+
+func TestConfigFile_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "linode")
+	require.NoError(t, os.WriteFile(path, []byte(configLinodeCLI), 0o600))
+
+	config, err := LoadConfigFile(path)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"alice", "bob"}, config.Profiles())
+	require.Equal(t, "alice", config.DefaultProfile())
+
+	alice, err := config.Profile("Alice")
+	require.NoError(t, err)
+	require.Equal(t, "alice-token", alice.APIToken)
+	require.Equal(t, map[string]string{"region": "us-east", "type": "g6-nanode-1"}, alice.Settings)
+
+	bob, err := config.Profile("bob")
+	require.NoError(t, err)
+	require.Equal(t, "v4beta", bob.APIVersion)
+	require.Equal(t, 5, *bob.RetryCount)
+	require.False(t, *bob.Debug)
+
+	// Updating a profile preserves its other settings
+	alice.APIToken = "new-alice-token"
+	alice.Settings = map[string]string{"image": "linode/debian12"}
+	require.NoError(t, config.SetProfile("alice", *alice))
+
+	debug := true
+	require.NoError(t, config.SetProfile("carol", ConfigFileProfile{
+		ConfigProfile: ConfigProfile{APIToken: "carol-token", CAPath: "/etc/ssl/ca.pem", Debug: &debug},
+	}))
+
+	require.NoError(t, config.DeleteProfile("bob"))
+	require.Error(t, config.DeleteProfile("bob"))
+	require.Error(t, config.SetDefaultProfile("bob"))
+	require.NoError(t, config.SetDefaultProfile("carol"))
+
+	require.Error(t, config.SetProfile("dave", ConfigFileProfile{Settings: map[string]string{"token": "x"}}))
+	require.NotContains(t, config.Profiles(), "dave")
+
+	require.NoError(t, config.Save())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "# Alice's account")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	config, err = LoadConfigFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "carol"}, config.Profiles())
+	require.Equal(t, "carol", config.DefaultProfile())
+
+	alice, err = config.Profile("alice")
+	require.NoError(t, err)
+	require.Equal(t, "new-alice-token", alice.APIToken)
+	require.Equal(t, map[string]string{"region": "us-east", "type": "g6-nanode-1", "image": "linode/debian12"}, alice.Settings)
+
+	carol, err := config.Profile("carol")
+	require.NoError(t, err)
+	require.Equal(t, "/etc/ssl/ca.pem", carol.CAPath)
+	require.True(t, *carol.Debug)
+	require.Nil(t, carol.RetryCount)
+}
+
+func TestConfigFile_LoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "linode")
+
+	config, err := LoadConfigFile(path)
+	require.NoError(t, err)
+	require.Empty(t, config.Profiles())
+
+	retryCount := 2
+
+	require.NoError(t, config.SetProfile("ops", ConfigFileProfile{
+		ConfigProfile: ConfigProfile{APIToken: "ops-token", APIURL: "api.cool.linode.com", RetryCount: &retryCount},
+	}))
+	require.NoError(t, config.SetDefaultProfile("ops"))
+	require.NoError(t, config.Save())
+
+	// Files written by ConfigFile can be loaded by clients, using the default profile
+	client := newTestClient(t, nil)
+	require.NoError(t, client.LoadConfig(&LoadConfigOptions{Path: path}))
+
+	require.Equal(t, "Bearer ops-token", client.header.Get("Authorization"))
+	require.Equal(t, "https://api.cool.linode.com/v4", client.hostURL)
+	require.Equal(t, 2, client.retryCount)
+}
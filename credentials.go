@@ -0,0 +1,355 @@
+package linodego
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// ErrNoCredentials is returned by a CredentialProvider that has no credentials to provide.
+// A CredentialChain moves on to the next provider when it is returned.
+var ErrNoCredentials = errors.New("no credentials found")
+
+// Credentials are the credentials used to authenticate requests to the Linode API.
+type Credentials struct {
+	// Token is the API token sent in the Authorization header.
+	Token string
+
+	// Expiry is when the token expires. It is zero if the expiry is not known.
+	Expiry time.Time
+}
+
+// CredentialProvider provides the credentials used by a client.
+//
+// A client with a credential provider (see Client.SetCredentialProvider) requests credentials for each
+// request, so providers that are expensive to query should cache their credentials. Providers that cache
+// credentials should also implement CredentialInvalidator, so credentials rejected by the API are not reused.
+type CredentialProvider interface {
+	// Credentials returns the current credentials, or ErrNoCredentials if there are none.
+	Credentials(ctx context.Context) (*Credentials, error)
+}
+
+// CredentialInvalidator is implemented by credential providers that cache credentials.
+// When the API rejects a request with a 401 Unauthorized response, the client invalidates the
+// credentials and retries the request once with fresh credentials.
+type CredentialInvalidator interface {
+	InvalidateCredentials()
+}
+
+// CredentialProviderFunc is a CredentialProvider implemented by a function.
+type CredentialProviderFunc func(ctx context.Context) (*Credentials, error)
+
+func (f CredentialProviderFunc) Credentials(ctx context.Context) (*Credentials, error) {
+	return f(ctx)
+}
+
+// SetCredentialProvider sets the provider of the credentials used to authenticate requests.
+// Credentials from the provider take priority over a token set using SetToken.
+func (c *Client) SetCredentialProvider(provider CredentialProvider) *Client {
+	c.credentialProvider = provider
+	return c
+}
+
+// applyCredentials sets the Authorization header of the request using the client's credential provider.
+func (c *Client) applyCredentials(req *http.Request) error {
+	if c.credentialProvider == nil {
+		return nil
+	}
+
+	credentials, err := c.credentialProvider.Credentials(req.Context())
+	if err != nil {
+		return c.ErrorAndLogf("failed to get credentials: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+credentials.Token)
+
+	return nil
+}
+
+// invalidateCredentials invalidates the credentials cached by the client's credential provider,
+// returning whether the request should be retried with fresh credentials.
+func (c *Client) invalidateCredentials() bool {
+	invalidator, ok := c.credentialProvider.(CredentialInvalidator)
+	if !ok {
+		return false
+	}
+
+	invalidator.InvalidateCredentials()
+
+	return true
+}
+
+// CredentialChain is a CredentialProvider that returns the credentials of the first
+// provider in the chain that has credentials.
+type CredentialChain []CredentialProvider
+
+var _ CredentialInvalidator = CredentialChain(nil)
+
+func (c CredentialChain) Credentials(ctx context.Context) (*Credentials, error) {
+	for _, provider := range c {
+		credentials, err := provider.Credentials(ctx)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+
+		return credentials, err
+	}
+
+	return nil, ErrNoCredentials
+}
+
+// InvalidateCredentials invalidates the credentials of each provider in the chain.
+func (c CredentialChain) InvalidateCredentials() {
+	for _, provider := range c {
+		if invalidator, ok := provider.(CredentialInvalidator); ok {
+			invalidator.InvalidateCredentials()
+		}
+	}
+}
+
+// DefaultCredentialChain returns a chain that provides credentials from the LINODE_TOKEN
+// environment variable, followed by the config profile selected by the LINODE_CONFIG
+// and LINODE_PROFILE environment variables, matching NewClientFromEnv.
+func DefaultCredentialChain() CredentialChain {
+	return CredentialChain{
+		&EnvCredentialProvider{},
+		&ProfileCredentialProvider{
+			Path:    os.Getenv(APIConfigEnvVar),
+			Profile: os.Getenv(APIConfigProfileEnvVar),
+		},
+	}
+}
+
+// EnvCredentialProvider provides a token from an environment variable.
+type EnvCredentialProvider struct {
+	// Variable is the environment variable containing the token.
+	// Defaults to LINODE_TOKEN.
+	Variable string
+}
+
+func (p *EnvCredentialProvider) Credentials(_ context.Context) (*Credentials, error) {
+	variable := p.Variable
+	if variable == "" {
+		variable = APIEnvVar
+	}
+
+	token, ok := os.LookupEnv(variable)
+	if !ok || token == "" {
+		return nil, ErrNoCredentials
+	}
+
+	return &Credentials{Token: token}, nil
+}
+
+// ProfileCredentialProvider provides the token of a profile in a Linode config file.
+// The config file is read once, when credentials are first requested.
+type ProfileCredentialProvider struct {
+	// Path is the path of the config file. Defaults to the first of DefaultConfigPaths that exists.
+	Path string
+
+	// Profile is the name of the profile. Defaults to DefaultConfigProfile.
+	Profile string
+
+	once        sync.Once
+	credentials *Credentials
+	err         error
+}
+
+func (p *ProfileCredentialProvider) Credentials(_ context.Context) (*Credentials, error) {
+	p.once.Do(func() {
+		p.credentials, p.err = p.load()
+	})
+
+	return p.credentials, p.err
+}
+
+func (p *ProfileCredentialProvider) load() (*Credentials, error) {
+	path := p.Path
+	if path == "" {
+		var err error
+		if path, err = resolveValidConfigPath(); err != nil {
+			return nil, err
+		}
+
+		if path == "" {
+			return nil, ErrNoCredentials
+		}
+	}
+
+	profile := p.Profile
+	if profile == "" {
+		profile = DefaultConfigProfile
+	}
+
+	// Load the profiles into a throwaway client to reuse its config handling
+	var client Client
+	if err := client.LoadConfig(&LoadConfigOptions{Path: path, SkipLoadProfile: true}); err != nil {
+		return nil, fmt.Errorf("failed to load config file %s: %w", path, err)
+	}
+
+	config, ok := client.configProfiles[strings.ToLower(profile)]
+	if !ok || config.APIToken == "" {
+		return nil, ErrNoCredentials
+	}
+
+	return &Credentials{Token: config.APIToken}, nil
+}
+
+// FileCredentialProvider provides a token read from a file, such as a mounted Kubernetes secret.
+// The file is re-read whenever it changes, so rotated tokens are used without restarting.
+type FileCredentialProvider struct {
+	// Path is the path of the file containing the token.
+	// Leading and trailing whitespace is removed from the token.
+	Path string
+
+	mu          sync.Mutex
+	modTime     time.Time
+	size        int64
+	credentials *Credentials
+}
+
+var _ CredentialInvalidator = (*FileCredentialProvider)(nil)
+
+func (p *FileCredentialProvider) Credentials(_ context.Context) (*Credentials, error) {
+	info, err := os.Stat(p.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoCredentials
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.credentials != nil && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return p.credentials, nil
+	}
+
+	data, err := os.ReadFile(filepath.Clean(p.Path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return nil, ErrNoCredentials
+	}
+
+	p.credentials = &Credentials{Token: token}
+	p.modTime = info.ModTime()
+	p.size = info.Size()
+
+	return p.credentials, nil
+}
+
+// InvalidateCredentials causes the token file to be re-read on the next request.
+func (p *FileCredentialProvider) InvalidateCredentials() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.credentials = nil
+}
+
+// CommandCredentialProvider provides a token by running an external command, in the style
+// of a credential helper. The command must write a JSON object to stdout:
+//
+//	{"token": "...", "expiry": "2006-01-02T15:04:05Z"}
+//
+// The expiry is optional and in RFC 3339 format. The token is cached until it expires,
+// or until it is rejected by the API if it has no expiry.
+type CommandCredentialProvider struct {
+	// Command is the name or path of the command to run.
+	Command string
+
+	// Args are the arguments passed to the command.
+	Args []string
+
+	// RefreshWindow is how long before the token expires that the command is run again.
+	// Defaults to one minute.
+	RefreshWindow time.Duration
+
+	mu          sync.Mutex
+	credentials *Credentials
+}
+
+var _ CredentialInvalidator = (*CommandCredentialProvider)(nil)
+
+func (p *CommandCredentialProvider) Credentials(ctx context.Context) (*Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	refreshWindow := p.RefreshWindow
+	if refreshWindow == 0 {
+		refreshWindow = time.Minute
+	}
+
+	if p.credentials != nil && (p.credentials.Expiry.IsZero() || time.Until(p.credentials.Expiry) > refreshWindow) {
+		return p.credentials, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, p.Command, p.Args...) // #nosec G204 -- the command is configured by the user
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credential command %s failed: %w: %s", p.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var output struct {
+		Token  string    `json:"token"`
+		Expiry time.Time `json:"expiry"`
+	}
+
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return nil, fmt.Errorf("failed to decode output of credential command %s: %w", p.Command, err)
+	}
+
+	if output.Token == "" {
+		return nil, ErrNoCredentials
+	}
+
+	p.credentials = &Credentials{Token: output.Token, Expiry: output.Expiry}
+
+	return p.credentials, nil
+}
+
+// InvalidateCredentials causes the command to be run again on the next request.
+func (p *CommandCredentialProvider) InvalidateCredentials() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.credentials = nil
+}
+
+// TokenSourceCredentialProvider provides tokens from an OAuth2 token source.
+// The token source is queried for each request, so it should cache its tokens,
+// e.g. using oauth2.ReuseTokenSource.
+type TokenSourceCredentialProvider struct {
+	TokenSource oauth2.TokenSource
+}
+
+func (p *TokenSourceCredentialProvider) Credentials(_ context.Context) (*Credentials, error) {
+	token, err := p.TokenSource.Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token from token source: %w", err)
+	}
+
+	if token.AccessToken == "" {
+		return nil, ErrNoCredentials
+	}
+
+	return &Credentials{Token: token.AccessToken, Expiry: token.Expiry}, nil
+}
@@ -0,0 +1,200 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestClient_CredentialProvider(t *testing.T) {
+	var (
+		validToken atomic.Value
+		requests   atomic.Int32
+	)
+
+	validToken.Store("first")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Header.Get("Authorization") != "Bearer "+validToken.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Invalid Token"}]}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": 123}`))
+	}))
+	defer server.Close()
+
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("first\n"), 0o600))
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetToken("static").SetCredentialProvider(CredentialChain{
+		&EnvCredentialProvider{Variable: "LINODEGO_TEST_UNSET_TOKEN"},
+		&FileCredentialProvider{Path: tokenPath},
+	})
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+
+	// Rotated tokens are picked up without restarting
+	validToken.Store("second")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("second\n"), 0o600))
+	require.NoError(t, os.Chtimes(tokenPath, time.Now(), time.Now().Add(time.Minute)))
+
+	requests.Store(0)
+
+	_, err = client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), requests.Load())
+
+	// Rejected credentials are invalidated and the request is retried once
+	validToken.Store("third")
+
+	provider := &CommandCredentialProvider{
+		Command: "sh",
+		Args:    []string{"-c", `echo "{\"token\": \"$(cat "$0")\"}"`, tokenPath},
+	}
+	client.SetCredentialProvider(provider)
+
+	_, err = client.GetInstance(context.Background(), 123)
+	require.True(t, ErrHasStatus(err, http.StatusUnauthorized))
+
+	require.NoError(t, os.WriteFile(tokenPath, []byte("third"), 0o600))
+
+	requests.Store(0)
+
+	_, err = client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), requests.Load())
+
+	// Providers without credentials are reported
+	client.SetCredentialProvider(CredentialChain{})
+
+	_, err = client.GetInstance(context.Background(), 123)
+	require.ErrorIs(t, err, ErrNoCredentials)
+}
+
+func TestCredentialProviders(t *testing.T) {
+	ctx := context.Background()
+
+	t.Setenv("LINODEGO_TEST_TOKEN", "env-token")
+
+	credentials, err := (&EnvCredentialProvider{Variable: "LINODEGO_TEST_TOKEN"}).Credentials(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "env-token", credentials.Token)
+
+	configPath := filepath.Join(t.TempDir(), "linode")
+	require.NoError(t, os.WriteFile(configPath, []byte(strings.Join([]string{
+		"[default]",
+		"token = default-token",
+		"[other]",
+		"token = other-token",
+	}, "\n")), 0o600))
+
+	credentials, err = (&ProfileCredentialProvider{Path: configPath, Profile: "Other"}).Credentials(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "other-token", credentials.Token)
+
+	_, err = (&ProfileCredentialProvider{Path: configPath, Profile: "missing"}).Credentials(ctx)
+	require.ErrorIs(t, err, ErrNoCredentials)
+
+	_, err = (&FileCredentialProvider{Path: filepath.Join(t.TempDir(), "missing")}).Credentials(ctx)
+	require.ErrorIs(t, err, ErrNoCredentials)
+
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	credentials, err = (&TokenSourceCredentialProvider{
+		TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "oauth-token", Expiry: expiry}),
+	}).Credentials(ctx)
+	require.NoError(t, err)
+	require.Equal(t, Credentials{Token: "oauth-token", Expiry: expiry}, *credentials)
+
+	command := &CommandCredentialProvider{
+		Command: "sh",
+		Args:    []string{"-c", `echo '{"token": "command-token", "expiry": "2100-01-02T15:04:05Z"}'`},
+	}
+
+	credentials, err = command.Credentials(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "command-token", credentials.Token)
+	require.Equal(t, 2100, credentials.Expiry.Year())
+
+	_, err = (&CommandCredentialProvider{Command: "sh", Args: []string{"-c", "echo failed >&2; exit 1"}}).Credentials(ctx)
+	require.ErrorContains(t, err, "failed")
+}
+
+// rotatingCredentialProvider returns a new token each time its credentials are invalidated.
+type rotatingCredentialProvider struct {
+	rotations atomic.Int32
+}
+
+func (p *rotatingCredentialProvider) Credentials(context.Context) (*Credentials, error) {
+	return &Credentials{Token: fmt.Sprintf("token-%d", p.rotations.Load())}, nil
+}
+
+func (p *rotatingCredentialProvider) InvalidateCredentials() {
+	p.rotations.Add(1)
+}
+
+func TestClient_CredentialRefreshRateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(RateLimitLimitHeaderName, "100")
+		w.Header().Set(RateLimitRemainingHeaderName, "99")
+
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Invalid Token"}]}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": 123}`))
+	}))
+	defer server.Close()
+
+	var (
+		mu     sync.Mutex
+		tokens []string
+	)
+
+	// The family of a request is computed when waiting for it and when updating from its response
+	limiter := NewRateLimiter(RateLimiterOptions{
+		Family: func(req *http.Request) string {
+			mu.Lock()
+			defer mu.Unlock()
+
+			tokens = append(tokens, strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "))
+
+			return DefaultRateLimitFamily(req)
+		},
+	})
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetRateLimiter(limiter).SetCredentialProvider(&rotatingCredentialProvider{})
+
+	_, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	require.Equal(t, []string{"token-0", "token-0", "token-1", "token-1"}, tokens)
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -235,11 +236,23 @@ func (c *Client) ListDatabases(ctx context.Context, opts *ListOptions) ([]Databa
 	return getPaginatedResults[Database](ctx, c, "databases/instances", opts)
 }
 
+// IterDatabases returns an iterator over the results of ListDatabases,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterDatabases(ctx context.Context, opts *ListOptions) iter.Seq2[Database, error] {
+	return getPaginatedIter[Database](ctx, c, "databases/instances", opts)
+}
+
 // ListDatabaseEngines lists all Database Engines. This endpoint is cached by default.
 func (c *Client) ListDatabaseEngines(ctx context.Context, opts *ListOptions) ([]DatabaseEngine, error) {
 	return getPaginatedResults[DatabaseEngine](ctx, c, "databases/engines", opts)
 }
 
+// IterDatabaseEngines returns an iterator over the results of ListDatabaseEngines,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterDatabaseEngines(ctx context.Context, opts *ListOptions) iter.Seq2[DatabaseEngine, error] {
+	return getPaginatedIter[DatabaseEngine](ctx, c, "databases/engines", opts)
+}
+
 // GetDatabaseEngine returns a specific Database Engine. This endpoint is cached by default.
 func (c *Client) GetDatabaseEngine(ctx context.Context, _ *ListOptions, engineID string) (*DatabaseEngine, error) {
 	e := formatAPIPath("databases/engines/%s", engineID)
@@ -251,6 +264,12 @@ func (c *Client) ListDatabaseTypes(ctx context.Context, opts *ListOptions) ([]Da
 	return getPaginatedResults[DatabaseType](ctx, c, "databases/types", opts)
 }
 
+// IterDatabaseTypes returns an iterator over the results of ListDatabaseTypes,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterDatabaseTypes(ctx context.Context, opts *ListOptions) iter.Seq2[DatabaseType, error] {
+	return getPaginatedIter[DatabaseType](ctx, c, "databases/types", opts)
+}
+
 // GetDatabaseType returns a specific Database Type. This endpoint is cached by default.
 func (c *Client) GetDatabaseType(ctx context.Context, _ *ListOptions, typeID string) (*DatabaseType, error) {
 	e := formatAPIPath("databases/types/%s", typeID)
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -113,6 +114,12 @@ func (c *Client) ListDomainRecords(ctx context.Context, domainID int, opts *List
 	return getPaginatedResults[DomainRecord](ctx, c, formatAPIPath("domains/%d/records", domainID), opts)
 }
 
+// IterDomainRecords returns an iterator over the results of ListDomainRecords,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterDomainRecords(ctx context.Context, domainID int, opts *ListOptions) iter.Seq2[DomainRecord, error] {
+	return getPaginatedIter[DomainRecord](ctx, c, formatAPIPath("domains/%d/records", domainID), opts)
+}
+
 // GetDomainRecord gets the domainrecord with the provided ID
 func (c *Client) GetDomainRecord(ctx context.Context, domainID int, recordID int) (*DomainRecord, error) {
 	e := formatAPIPath("domains/%d/records/%d", domainID, recordID)
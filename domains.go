@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // Domain represents a Domain object
@@ -188,6 +189,12 @@ func (c *Client) ListDomains(ctx context.Context, opts *ListOptions) ([]Domain,
 	return getPaginatedResults[Domain](ctx, c, "domains", opts)
 }
 
+// IterDomains returns an iterator over the results of ListDomains,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterDomains(ctx context.Context, opts *ListOptions) iter.Seq2[Domain, error] {
+	return getPaginatedIter[Domain](ctx, c, "domains", opts)
+}
+
 // GetDomain gets the domain with the provided ID
 func (c *Client) GetDomain(ctx context.Context, domainID int) (*Domain, error) {
 	e := formatAPIPath("domains/%d", domainID)
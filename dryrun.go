@@ -0,0 +1,126 @@
+package linodego
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+// PlannedRequest is a mutating request that was captured by a client
+// in dry-run mode instead of being sent to the API.
+type PlannedRequest struct {
+	// Method is the HTTP method of the request, e.g. POST.
+	Method string `json:"method"`
+
+	// Endpoint is the API endpoint relative to the API version,
+	// e.g. linode/instances/123.
+	Endpoint string `json:"endpoint"`
+
+	// Body is the JSON-decoded request body, or nil if the request has no body.
+	Body any `json:"body,omitempty"`
+}
+
+// dryRunPlan holds the requests captured by a client in dry-run mode.
+// It is shared by copies of the client so requests made through
+// WaitFor* helpers are captured in the same plan.
+type dryRunPlan struct {
+	mu       sync.Mutex
+	requests []PlannedRequest
+}
+
+// SetDryRun enables or disables dry-run mode.
+//
+// In dry-run mode GET requests are sent to the API as usual, but POST, PUT and DELETE
+// requests are captured in the client's plan (see DryRunPlan) instead of being sent.
+// Captured requests succeed with a zero-value response.
+//
+// Disabling dry-run mode discards the captured plan.
+func (c *Client) SetDryRun(dryRun bool) *Client {
+	switch {
+	case !dryRun:
+		c.dryRun = nil
+	case c.dryRun == nil:
+		c.dryRun = &dryRunPlan{}
+	}
+
+	return c
+}
+
+// IsDryRun returns whether the client is in dry-run mode.
+func (c *Client) IsDryRun() bool {
+	return c.dryRun != nil
+}
+
+// DryRunPlan returns the requests captured in dry-run mode, in the order they were made.
+func (c *Client) DryRunPlan() []PlannedRequest {
+	if c.dryRun == nil {
+		return nil
+	}
+
+	c.dryRun.mu.Lock()
+	defer c.dryRun.mu.Unlock()
+
+	return slices.Clone(c.dryRun.requests)
+}
+
+// ResetDryRunPlan discards the requests captured in dry-run mode.
+func (c *Client) ResetDryRunPlan() {
+	if c.dryRun == nil {
+		return
+	}
+
+	c.dryRun.mu.Lock()
+	defer c.dryRun.mu.Unlock()
+
+	c.dryRun.requests = nil
+}
+
+// isDryRunMethod returns whether requests with the given method are
+// captured rather than sent in dry-run mode.
+func isDryRunMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// planRequest captures the given request in the client's dry-run plan.
+func (c *Client) planRequest(method, endpoint string, params requestParams) error {
+	planned := PlannedRequest{
+		Method:   method,
+		Endpoint: endpoint,
+	}
+
+	if params.Body != nil {
+		if _, err := params.Body.Seek(0, io.SeekStart); err != nil {
+			return c.ErrorAndLogf("failed to seek to the start of the body: %v", err.Error())
+		}
+
+		body, err := io.ReadAll(params.Body)
+		if err != nil {
+			return c.ErrorAndLogf("failed to read request body: %v", err.Error())
+		}
+
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &planned.Body); err != nil {
+				return fmt.Errorf("failed to decode planned request body: %w", err)
+			}
+		}
+	}
+
+	if c.debug && c.logger != nil {
+		c.logger.Debugf("[DRY RUN] %s %s", method, endpoint)
+	}
+
+	c.dryRun.mu.Lock()
+	defer c.dryRun.mu.Unlock()
+
+	c.dryRun.requests = append(c.dryRun.requests, planned)
+
+	return nil
+}
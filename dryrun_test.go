@@ -0,0 +1,71 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	var mutatingRequests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			mutatingRequests.Add(1)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123, "label": "existing", "status": "running"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetDryRun(true)
+
+	require.True(t, client.IsDryRun())
+
+	// GET requests are still sent
+	instance, err := client.GetInstance(context.Background(), 123)
+	require.NoError(t, err)
+	require.Equal(t, "existing", instance.Label)
+
+	instance, err = client.CreateInstance(context.Background(), InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+		Label:  "planned",
+	})
+	require.NoError(t, err)
+	require.Equal(t, Instance{}, *instance)
+
+	_, err = client.UpdateFirewallRules(context.Background(), 456, FirewallRulesUpdateOptions{InboundPolicy: "DROP"})
+	require.NoError(t, err)
+
+	require.NoError(t, client.DeleteVolume(context.Background(), 789))
+	require.Zero(t, mutatingRequests.Load())
+
+	plan := client.DryRunPlan()
+	require.Len(t, plan, 3)
+
+	require.Equal(t, http.MethodPost, plan[0].Method)
+	require.Equal(t, "linode/instances", plan[0].Endpoint)
+	require.Equal(t, "planned", plan[0].Body.(map[string]any)["label"])
+
+	require.Equal(t, http.MethodPut, plan[1].Method)
+	require.Equal(t, "networking/firewalls/456/rules", plan[1].Endpoint)
+	require.Equal(t, "DROP", plan[1].Body.(map[string]any)["inbound_policy"])
+
+	require.Equal(t, PlannedRequest{Method: http.MethodDelete, Endpoint: "volumes/789"}, plan[2])
+
+	client.ResetDryRunPlan()
+	require.Empty(t, client.DryRunPlan())
+
+	client.SetDryRun(false)
+	require.False(t, client.IsDryRun())
+
+	require.NoError(t, client.DeleteVolume(context.Background(), 789))
+	require.Equal(t, int32(1), mutatingRequests.Load())
+}
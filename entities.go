@@ -1,6 +1,9 @@
 package linodego
 
-import "context"
+import (
+	"context"
+	"iter"
+)
 
 // LinodeEntity is anything in Linode with IAM Permissions
 type LinodeEntity struct {
@@ -14,6 +17,12 @@ func (c *Client) ListEntities(ctx context.Context, opts *ListOptions) ([]LinodeE
 	return getPaginatedResults[LinodeEntity](ctx, c, "entities", opts)
 }
 
+// IterEntities returns an iterator over the results of ListEntities,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterEntities(ctx context.Context, opts *ListOptions) iter.Seq2[LinodeEntity, error] {
+	return getPaginatedIter[LinodeEntity](ctx, c, "entities", opts)
+}
+
 // GetEntityRoles returns a list of roles for the entity and user
 func (c *Client) GetEntityRoles(ctx context.Context, username string, entityType string, entityID int) ([]string, error) {
 	perms, err := doGETRequest[[]string](ctx, c,
@@ -21,11 +21,74 @@ const (
 	ErrorFromStringer
 )
 
+// Sentinel errors identifying classes of errors returned by the Linode API.
+// They can be matched against an [Error] using errors.Is, e.g.
+//
+//	if errors.Is(err, linodego.ErrEntityBusy) {
+//		// try again later
+//	}
+var (
+	// ErrNotFound matches 404 Not Found errors.
+	ErrNotFound = errors.New("not found")
+	// ErrRateLimited matches 429 Too Many Requests errors.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrMaintenance matches errors returned while the API is in maintenance mode,
+	// as indicated by the X-Maintenance-Mode response header.
+	ErrMaintenance = errors.New("api in maintenance mode")
+	// ErrEntityBusy matches errors returned when an entity is busy with another operation,
+	// such as "Linode busy." or "Volume busy.". Note that LinodeBusyRetryCondition only
+	// retries requests that failed with exactly "Linode busy.".
+	ErrEntityBusy = errors.New("entity busy")
+	// ErrUnauthorized matches 401 Unauthorized errors, returned for missing or invalid tokens.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbiddenScope matches 403 Forbidden errors, returned when the token or user
+	// does not have the scope or grant required for the request.
+	ErrForbiddenScope = errors.New("forbidden scope")
+)
+
 // Error wraps the LinodeGo error with the relevant http.Response
 type Error struct {
 	Response *http.Response
 	Code     int
 	Message  string
+
+	// Reasons are the reasons returned by the Linode API, if any.
+	Reasons []APIErrorReason
+}
+
+// ValidationError is a 400 Bad Request error with reasons for individual fields of the request.
+// It can be extracted from an [Error] using errors.As:
+//
+//	var validationErr *linodego.ValidationError
+//	if errors.As(err, &validationErr) {
+//		for _, reason := range validationErr.FieldReasons("label") { ... }
+//	}
+type ValidationError struct {
+	Err *Error
+
+	// Reasons are the reasons returned by the Linode API.
+	Reasons []APIErrorReason
+}
+
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// FieldReasons returns the reasons for the given field of the request.
+func (e *ValidationError) FieldReasons(field string) []APIErrorReason {
+	var reasons []APIErrorReason
+
+	for _, reason := range e.Reasons {
+		if reason.Field == field {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	return reasons
 }
 
 // APIErrorReason is an individual invalid request message returned by the Linode API
@@ -110,6 +173,13 @@ func coupleAPIErrors(resp *http.Response, err error) (*http.Response, error) {
 	return resp, nil
 }
 
+// isEntityBusy reports whether the API rejected the request because the entity is busy.
+func (e APIError) isEntityBusy() bool {
+	return slices.ContainsFunc(e.Errors, func(reason APIErrorReason) bool {
+		return strings.HasSuffix(reason.Reason, " busy.")
+	})
+}
+
 func (e APIError) Error() string {
 	x := make([]string, 0, len(e.Errors))
 	for _, msg := range e.Errors {
@@ -143,6 +213,7 @@ func NewError(err any) *Error {
 			Code:     e.StatusCode,
 			Message:  apiError.Error(),
 			Response: e,
+			Reasons:  apiError.Errors,
 		}
 	case error:
 		return &Error{Code: ErrorFromError, Message: e.Error()}
@@ -164,6 +235,21 @@ func (err Error) StatusCode() int {
 }
 
 func (err Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return err.Code == http.StatusNotFound
+	case ErrRateLimited:
+		return err.Code == http.StatusTooManyRequests
+	case ErrMaintenance:
+		return err.Response != nil && err.Response.Header.Get(MaintenanceModeHeaderName) != ""
+	case ErrEntityBusy:
+		return err.Code == http.StatusBadRequest && APIError{Errors: err.Reasons}.isEntityBusy()
+	case ErrUnauthorized:
+		return err.Code == http.StatusUnauthorized
+	case ErrForbiddenScope:
+		return err.Code == http.StatusForbidden
+	}
+
 	if x, ok := target.(interface{ StatusCode() int }); ok || errors.As(target, &x) {
 		return err.StatusCode() == x.StatusCode()
 	}
@@ -171,6 +257,23 @@ func (err Error) Is(target error) bool {
 	return false
 }
 
+// As supports extracting a *ValidationError from 400 Bad Request errors
+// with reasons for individual fields.
+func (err Error) As(target any) bool {
+	validationErr, ok := target.(**ValidationError)
+	if !ok || err.Code != http.StatusBadRequest {
+		return false
+	}
+
+	if !slices.ContainsFunc(err.Reasons, func(reason APIErrorReason) bool { return reason.Field != "" }) {
+		return false
+	}
+
+	*validationErr = &ValidationError{Err: &err, Reasons: err.Reasons}
+
+	return true
+}
+
 // IsNotFound indicates if err indicates a 404 Not Found error from the Linode API.
 func IsNotFound(err error) bool {
 	return ErrHasStatus(err, http.StatusNotFound)
@@ -14,6 +14,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
 )
 
 type testStringer string
@@ -442,3 +443,96 @@ func TestErrHasStatusCode(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorSentinels(t *testing.T) {
+	t.Parallel()
+
+	newResponse := func(status int, header http.Header, reasons ...APIErrorReason) *http.Response {
+		body, err := json.Marshal(APIError{Errors: reasons})
+		require.NoError(t, err)
+
+		if header == nil {
+			header = http.Header{}
+		}
+
+		header.Set("Content-Type", "application/json")
+
+		return &http.Response{
+			StatusCode: status,
+			Header:     header,
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Request:    &http.Request{Header: http.Header{"Accept": []string{"application/json"}}},
+		}
+	}
+
+	sentinels := []error{ErrNotFound, ErrRateLimited, ErrMaintenance, ErrEntityBusy, ErrUnauthorized, ErrForbiddenScope}
+
+	tests := []struct {
+		name     string
+		response *http.Response
+		want     error
+	}{
+		{"not found", newResponse(http.StatusNotFound, nil, APIErrorReason{Reason: "Not found"}), ErrNotFound},
+		{"rate limited", newResponse(http.StatusTooManyRequests, nil, APIErrorReason{Reason: "Too many requests"}), ErrRateLimited},
+		{
+			"maintenance",
+			newResponse(
+				http.StatusServiceUnavailable,
+				http.Header{MaintenanceModeHeaderName: []string{"API under maintenance"}},
+				APIErrorReason{Reason: "Service Unavailable"},
+			),
+			ErrMaintenance,
+		},
+		{"entity busy", newResponse(http.StatusBadRequest, nil, APIErrorReason{Reason: "Linode busy."}), ErrEntityBusy},
+		{"other entity busy", newResponse(http.StatusBadRequest, nil, APIErrorReason{Reason: "Volume busy."}), ErrEntityBusy},
+		{"unauthorized", newResponse(http.StatusUnauthorized, nil, APIErrorReason{Reason: "Invalid Token"}), ErrUnauthorized},
+		{"forbidden", newResponse(http.StatusForbidden, nil, APIErrorReason{Reason: "Unauthorized"}), ErrForbiddenScope},
+		{"other", newResponse(http.StatusInternalServerError, nil, APIErrorReason{Reason: "Unknown"}), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := coupleAPIErrors(tt.response, nil)
+			require.Error(t, err)
+
+			wrapped := fmt.Errorf("wrapped: %w", err)
+
+			for _, sentinel := range sentinels {
+				require.Equal(t, sentinel == tt.want, errors.Is(wrapped, sentinel), sentinel.Error())
+			}
+
+			var validationErr *ValidationError
+			require.False(t, errors.As(wrapped, &validationErr))
+		})
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	t.Parallel()
+
+	body, err := json.Marshal(APIError{Errors: []APIErrorReason{
+		{Field: "label", Reason: "Label must be unique"},
+		{Field: "label", Reason: "Label is too long"},
+		{Field: "region", Reason: "Region is invalid"},
+	}})
+	require.NoError(t, err)
+
+	_, err = coupleAPIErrors(&http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    &http.Request{Header: http.Header{"Accept": []string{"application/json"}}},
+	}, nil)
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, fmt.Errorf("wrapped: %w", err), &validationErr)
+	require.Len(t, validationErr.Reasons, 3)
+	require.Equal(t, []APIErrorReason{
+		{Field: "label", Reason: "Label must be unique"},
+		{Field: "label", Reason: "Label is too long"},
+	}, validationErr.FieldReasons("label"))
+	require.Empty(t, validationErr.FieldReasons("type"))
+
+	require.True(t, ErrHasStatus(validationErr, http.StatusBadRequest))
+	require.False(t, errors.Is(validationErr, ErrEntityBusy))
+}
@@ -1,7 +1,14 @@
 package linodego
 
 import (
+	"cmp"
 	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
 )
 
 type FilterOperator string
@@ -18,6 +25,15 @@ const (
 	Descending                = "desc"
 )
 
+const (
+	filterAnd     = "+and"
+	filterOr      = "+or"
+	filterOrder   = "+order"
+	filterOrderBy = "+order_by"
+)
+
+var validFilterOperators = []FilterOperator{Eq, Neq, Gt, Gte, Lt, Lte, Contains}
+
 type FilterNode interface {
 	Key() string
 	JSONValueSegment() any
@@ -26,6 +42,7 @@ type FilterNode interface {
 type Filter struct {
 	// Operator is the logic for all Children nodes ("+and"/"+or")
 	Operator string
+	// Children may contain Comp leaves and nested Filter groups
 	Children []FilterNode
 	// OrderBy is the field you want to order your results by (ex: "+order_by": "class")
 	OrderBy string
@@ -33,29 +50,62 @@ type Filter struct {
 	Order string
 }
 
+var _ FilterNode = (*Filter)(nil)
+
 func (f *Filter) AddField(op FilterOperator, key string, value any) {
 	f.Children = append(f.Children, &Comp{key, op, value})
 }
 
+// AddNode adds the given nodes (e.g. nested And/Or groups) to the filter.
+func (f *Filter) AddNode(nodes ...FilterNode) {
+	f.Children = append(f.Children, nodes...)
+}
+
 func (f *Filter) MarshalJSON() ([]byte, error) {
 	result := make(map[string]any)
 
 	if f.OrderBy != "" {
-		result["+order_by"] = f.OrderBy
+		result[filterOrderBy] = f.OrderBy
 	}
 
 	if f.Order != "" {
-		result["+order"] = f.Order
+		result[filterOrder] = f.Order
 	}
 
 	if f.Operator == "" {
+		fields := make(map[string]any)
+
 		for _, c := range f.Children {
-			result[c.Key()] = c.JSONValueSegment()
+			// Children that cannot be merged into a single object, such as two nested groups
+			// with the same operator, are combined using an explicit "+and" instead
+			if !addFilterSegment(fields, c.Key(), c.JSONValueSegment()) {
+				result[filterAnd] = f.JSONValueSegment()
+				return json.Marshal(result)
+			}
 		}
 
+		maps.Copy(result, fields)
+
 		return json.Marshal(result)
 	}
 
+	result[f.Operator] = f.JSONValueSegment()
+
+	return json.Marshal(result)
+}
+
+// Key returns the logical operator of the filter when it is nested in another filter.
+// Filters without an operator are treated as "+and" groups.
+func (f *Filter) Key() string {
+	if f.Operator == "" {
+		return filterAnd
+	}
+
+	return f.Operator
+}
+
+// JSONValueSegment returns the children of the filter when it is nested in another filter.
+func (f *Filter) JSONValueSegment() any {
 	fields := make([]map[string]any, len(f.Children))
 	for i, c := range f.Children {
 		fields[i] = map[string]any{
@@ -63,9 +113,28 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		}
 	}
 
-	result[f.Operator] = fields
+	return fields
+}
 
-	return json.Marshal(result)
+// Validate checks that the filter and all of its children use valid keys and operators.
+// Filters are not validated when they are marshaled, so Validate must be called explicitly
+// (or a FilterSchema set on the ListOptions) to check a filter before it is sent.
+func (f *Filter) Validate() error {
+	if f.Operator != "" && f.Operator != filterAnd && f.Operator != filterOr {
+		return fmt.Errorf("invalid filter operator %q: expected %q or %q", f.Operator, filterAnd, filterOr)
+	}
+
+	if f.Order != "" && f.Order != Ascending && f.Order != Descending {
+		return fmt.Errorf("invalid filter order %q: expected %q or %q", f.Order, Ascending, Descending)
+	}
+
+	for _, c := range f.Children {
+		if err := validateFilterNode(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 type Comp struct {
@@ -74,6 +143,8 @@ type Comp struct {
 	Value    any
 }
 
+var _ FilterNode = (*Comp)(nil)
+
 func (c *Comp) Key() string {
 	return c.Column
 }
@@ -88,6 +159,23 @@ func (c *Comp) JSONValueSegment() any {
 	}
 }
 
+// Validate checks that the comparison uses a valid key and operator.
+func (c *Comp) Validate() error {
+	if c.Column == "" {
+		return fmt.Errorf("filter key must not be empty")
+	}
+
+	if strings.HasPrefix(c.Column, "+") {
+		return fmt.Errorf("invalid filter key %q: keys must not start with '+'", c.Column)
+	}
+
+	if !slices.Contains(validFilterOperators, c.Operator) {
+		return fmt.Errorf("invalid filter operator %q for key %q", c.Operator, c.Column)
+	}
+
+	return nil
+}
+
 func Or(order string, orderBy string, nodes ...FilterNode) *Filter {
 	return &Filter{"+or", nodes, orderBy, order}
 }
@@ -95,3 +183,309 @@ func Or(order string, orderBy string, nodes ...FilterNode) *Filter {
 func And(order string, orderBy string, nodes ...FilterNode) *Filter {
 	return &Filter{"+and", nodes, orderBy, order}
 }
+
+// FieldEq returns a node matching entries where key is equal to value.
+func FieldEq(key string, value any) *Comp {
+	return &Comp{key, Eq, value}
+}
+
+// FieldNeq returns a node matching entries where key is not equal to value.
+func FieldNeq(key string, value any) *Comp {
+	return &Comp{key, Neq, value}
+}
+
+// FieldGt returns a node matching entries where key is greater than value.
+func FieldGt[T cmp.Ordered](key string, value T) *Comp {
+	return &Comp{key, Gt, value}
+}
+
+// FieldGte returns a node matching entries where key is greater than or equal to value.
+func FieldGte[T cmp.Ordered](key string, value T) *Comp {
+	return &Comp{key, Gte, value}
+}
+
+// FieldLt returns a node matching entries where key is less than value.
+func FieldLt[T cmp.Ordered](key string, value T) *Comp {
+	return &Comp{key, Lt, value}
+}
+
+// FieldLte returns a node matching entries where key is less than or equal to value.
+func FieldLte[T cmp.Ordered](key string, value T) *Comp {
+	return &Comp{key, Lte, value}
+}
+
+// FieldContains returns a node matching entries where key contains the given substring.
+func FieldContains(key, value string) *Comp {
+	return &Comp{key, Contains, value}
+}
+
+// ParseFilter parses an X-Filter JSON string into a Filter.
+// Objects containing multiple keys within an "+and"/"+or" group are parsed
+// as nested "+and" groups, matching the API's semantics.
+func ParseFilter(filter string) (*Filter, error) {
+	var raw map[string]any
+
+	decoder := json.NewDecoder(strings.NewReader(filter))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to parse filter: %w", err)
+	}
+
+	result := &Filter{}
+
+	if v, ok := raw[filterOrderBy]; ok {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s value: expected string, got %T", filterOrderBy, v)
+		}
+
+		result.OrderBy = s
+
+		delete(raw, filterOrderBy)
+	}
+
+	if v, ok := raw[filterOrder]; ok {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s value: expected string, got %T", filterOrder, v)
+		}
+
+		result.Order = s
+
+		delete(raw, filterOrder)
+	}
+
+	// A single logical group at the top level is represented by the Filter's operator
+	if len(raw) == 1 {
+		for key, value := range raw {
+			if key == filterAnd || key == filterOr {
+				children, err := parseFilterGroup(key, value)
+				if err != nil {
+					return nil, err
+				}
+
+				result.Operator = key
+				result.Children = children
+
+				return result, result.Validate()
+			}
+		}
+	}
+
+	children, err := parseFilterObject(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Children = children
+
+	return result, result.Validate()
+}
+
+// FilterSchema describes the fields of a resource that can be filtered on,
+// mapped to the operators each field supports.
+// A nil or empty operator list allows all operators for the field.
+type FilterSchema map[string][]FilterOperator
+
+// Validate checks that the given filter node only references filterable
+// fields and supported operators.
+func (s FilterSchema) Validate(node FilterNode) error {
+	switch n := node.(type) {
+	case *Filter:
+		if n.OrderBy != "" {
+			if _, ok := s[n.OrderBy]; !ok {
+				return fmt.Errorf("field %q cannot be used to order results", n.OrderBy)
+			}
+		}
+
+		for _, c := range n.Children {
+			if err := s.Validate(c); err != nil {
+				return err
+			}
+		}
+	case *Comp:
+		operators, ok := s[n.Column]
+		if !ok {
+			return fmt.Errorf("field %q is not filterable", n.Column)
+		}
+
+		if len(operators) > 0 && !slices.Contains(operators, n.Operator) {
+			return fmt.Errorf("operator %q is not supported for field %q", n.Operator, n.Column)
+		}
+	default:
+		return fmt.Errorf("unsupported filter node type %T", node)
+	}
+
+	return nil
+}
+
+// InstanceFilterSchema describes the filterable fields of Linode Instances.
+var InstanceFilterSchema = FilterSchema{
+	"id":     nil,
+	"label":  nil,
+	"group":  nil,
+	"region": {Eq, Neq},
+	"type":   {Eq, Neq},
+	"image":  {Eq, Neq},
+	"status": {Eq, Neq},
+	"tags":   {Eq, Neq},
+}
+
+// ValidateString parses the given X-Filter JSON string and validates it against the schema.
+func (s FilterSchema) ValidateString(filter string) error {
+	f, err := ParseFilter(filter)
+	if err != nil {
+		return err
+	}
+
+	return s.Validate(f)
+}
+
+func parseFilterObject(raw map[string]any) ([]FilterNode, error) {
+	// Sort the keys so parsed filters are deterministic
+	keys := slices.Collect(maps.Keys(raw))
+	sort.Strings(keys)
+
+	result := make([]FilterNode, 0, len(keys))
+
+	for _, key := range keys {
+		value := raw[key]
+
+		if key == filterAnd || key == filterOr {
+			children, err := parseFilterGroup(key, value)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, &Filter{Operator: key, Children: children})
+
+			continue
+		}
+
+		comps, err := parseFilterComps(key, value)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, comps...)
+	}
+
+	return result, nil
+}
+
+func parseFilterGroup(operator string, value any) ([]FilterNode, error) {
+	entries, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s value: expected array, got %T", operator, value)
+	}
+
+	result := make([]FilterNode, 0, len(entries))
+
+	for _, entry := range entries {
+		obj, ok := entry.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s entry: expected object, got %T", operator, entry)
+		}
+
+		children, err := parseFilterObject(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(children) == 1 {
+			result = append(result, children[0])
+			continue
+		}
+
+		result = append(result, &Filter{Operator: filterAnd, Children: children})
+	}
+
+	return result, nil
+}
+
+func parseFilterComps(key string, value any) ([]FilterNode, error) {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return []FilterNode{&Comp{key, Eq, parseFilterValue(value)}}, nil
+	}
+
+	operators := slices.Collect(maps.Keys(obj))
+	sort.Strings(operators)
+
+	result := make([]FilterNode, 0, len(operators))
+
+	for _, op := range operators {
+		result = append(result, &Comp{key, FilterOperator(op), parseFilterValue(obj[op])})
+	}
+
+	return result, nil
+}
+
+// parseFilterValue converts JSON numbers into int64 or float64 values.
+func parseFilterValue(value any) any {
+	number, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+
+	if i, err := number.Int64(); err == nil {
+		return i
+	}
+
+	if f, err := number.Float64(); err == nil {
+		return f
+	}
+
+	return value
+}
+
+func validateFilterNode(node FilterNode) error {
+	if v, ok := node.(interface{ Validate() error }); ok {
+		return v.Validate()
+	}
+
+	return nil
+}
+
+// addFilterSegment adds the given segment to the result, merging operator
+// objects for the same key (e.g. a "+gte" and "+lte" range on a single field).
+// It returns false if the segment conflicts with the existing segment for the key.
+func addFilterSegment(result map[string]any, key string, segment any) bool {
+	current, exists := result[key]
+	if !exists || reflect.DeepEqual(current, segment) {
+		result[key] = segment
+		return true
+	}
+
+	existing, ok := current.(map[string]any)
+	ops, isMap := segment.(map[string]any)
+
+	if !ok || !isMap || !isOperatorObject(existing) || !isOperatorObject(ops) {
+		return false
+	}
+
+	merged := maps.Clone(existing)
+
+	for op, value := range ops {
+		if other, ok := merged[op]; ok && !reflect.DeepEqual(other, value) {
+			return false
+		}
+
+		merged[op] = value
+	}
+
+	result[key] = merged
+
+	return true
+}
+
+func isOperatorObject(obj map[string]any) bool {
+	for key := range obj {
+		if !strings.HasPrefix(key, "+") {
+			return false
+		}
+	}
+
+	return len(obj) > 0
+}
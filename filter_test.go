@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"encoding/json"
+	"net/http"
 	"reflect"
 	"testing"
 )
@@ -162,3 +163,211 @@ func TestFilterOr(t *testing.T) {
 		t.Fatal(string(result), " doesn't match ", string(expectedStr))
 	}
 }
+
+func TestFilterNested(t *testing.T) {
+	expected := map[string]any{
+		"+or": []map[string]any{
+			{"region": "us-east"},
+			{
+				"+and": []map[string]any{
+					{"vcpus": map[string]any{"+gt": 2}},
+					{"label": map[string]any{"+contains": "web"}},
+				},
+			},
+		},
+	}
+
+	expectedStr, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatalf("failed to marshal expected json: %v", err)
+	}
+
+	f := Or("", "",
+		FieldEq("region", "us-east"),
+		And("", "", FieldGt("vcpus", 2), FieldContains("label", "web")),
+	)
+
+	result, err := f.MarshalJSON()
+	if err != nil {
+		t.Fatalf("failed to marshal filter: %v", err)
+	}
+
+	if !reflect.DeepEqual(result, expectedStr) {
+		t.Fatal(string(result), " doesn't match ", string(expectedStr))
+	}
+}
+
+func TestFilterRange(t *testing.T) {
+	expected := `{"created":{"+gte":"2024-01-01T00:00:00","+lte":"2024-02-01T00:00:00"}}`
+
+	f := Filter{}
+	f.AddNode(
+		FieldGte("created", "2024-01-01T00:00:00"),
+		FieldLte("created", "2024-02-01T00:00:00"),
+	)
+
+	result, err := f.MarshalJSON()
+	if err != nil {
+		t.Fatalf("failed to marshal filter: %v", err)
+	}
+
+	if string(result) != expected {
+		t.Fatal(string(result), " doesn't match ", expected)
+	}
+}
+
+func TestFilterSiblingGroups(t *testing.T) {
+	expected := `{"+and":[{"+or":[{"region":"us-east"},{"region":"us-west"}]},` +
+		`{"+or":[{"type":"g6-standard-1"},{"type":"g6-standard-2"}]},{"status":"running"}],"+order_by":"created"}`
+
+	f := Filter{OrderBy: "created"}
+	f.AddNode(
+		Or("", "", FieldEq("region", "us-east"), FieldEq("region", "us-west")),
+		Or("", "", FieldEq("type", "g6-standard-1"), FieldEq("type", "g6-standard-2")),
+	)
+	f.AddField(Eq, "status", "running")
+
+	result, err := f.MarshalJSON()
+	if err != nil {
+		t.Fatalf("failed to marshal filter: %v", err)
+	}
+
+	if string(result) != expected {
+		t.Fatal(string(result), " doesn't match ", expected)
+	}
+
+	// Conflicting comparisons on the same field are combined in the same way
+	f = Filter{}
+	f.AddField(Gte, "id", 1)
+	f.AddField(Gte, "id", 2)
+
+	result, err = f.MarshalJSON()
+	if err != nil {
+		t.Fatalf("failed to marshal filter: %v", err)
+	}
+
+	if expected := `{"+and":[{"id":{"+gte":1}},{"id":{"+gte":2}}]}`; string(result) != expected {
+		t.Fatal(string(result), " doesn't match ", expected)
+	}
+}
+
+func TestFilterValidate(t *testing.T) {
+	invalid := []*Filter{
+		And("", "", FieldEq("", "value")),
+		And("", "", FieldEq("+and", "value")),
+		And("", "", &Comp{"label", FilterOperator("+like"), "value"}),
+		{Operator: "+xor", Children: []FilterNode{FieldEq("label", "value")}},
+		{Order: "sideways"},
+		Or("", "", And("", "", FieldEq("", "value"))),
+	}
+
+	for _, f := range invalid {
+		if err := f.Validate(); err == nil {
+			t.Errorf("expected validation error for filter %#v", f)
+		}
+
+		// Filters are only validated when asked to, so invalid filters still marshal
+		if _, err := json.Marshal(f); err != nil {
+			t.Errorf("unexpected marshal error for filter %#v: %s", f, err)
+		}
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	inputs := []string{
+		`{"class":"standard","vcpus":{"+gte":12},"+order_by":"class","+order":"asc"}`,
+		`{"+or":[{"region":"us-east"},{"+and":[{"vcpus":{"+gt":2}},{"label":{"+contains":"web"}}]}]}`,
+		`{"created":{"+gte":"2024-01-01T00:00:00","+lte":"2024-02-01T00:00:00"}}`,
+	}
+
+	for _, input := range inputs {
+		f, err := ParseFilter(input)
+		if err != nil {
+			t.Fatalf("failed to parse filter %s: %v", input, err)
+		}
+
+		result, err := json.Marshal(f)
+		if err != nil {
+			t.Fatalf("failed to marshal filter: %v", err)
+		}
+
+		var expected, actual any
+
+		if err := json.Unmarshal([]byte(input), &expected); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := json.Unmarshal(result, &actual); err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatal(string(result), " doesn't match ", input)
+		}
+	}
+
+	// Objects with multiple keys within a group are an implicit "+and"
+	f, err := ParseFilter(`{"+or":[{"region":"us-east","type":"g6-standard-1"},{"label":"web"}]}`)
+	if err != nil {
+		t.Fatalf("failed to parse filter: %v", err)
+	}
+
+	if f.Operator != "+or" || len(f.Children) != 2 {
+		t.Fatalf("unexpected filter: %#v", f)
+	}
+
+	if nested, ok := f.Children[0].(*Filter); !ok || nested.Operator != "+and" || len(nested.Children) != 2 {
+		t.Fatalf("expected nested +and group, got %#v", f.Children[0])
+	}
+
+	for _, input := range []string{`not json`, `{"+or":{"label":"web"}}`, `{"label":{"+like":"web"}}`} {
+		if _, err := ParseFilter(input); err == nil {
+			t.Errorf("expected error parsing filter %s", input)
+		}
+	}
+}
+
+func TestFilterSchema(t *testing.T) {
+	valid := Or("asc", "label", FieldEq("region", "us-east"), FieldContains("label", "web"))
+	if err := InstanceFilterSchema.Validate(valid); err != nil {
+		t.Fatalf("unexpected schema error: %v", err)
+	}
+
+	invalid := []string{
+		`{"ipv4":"192.0.2.1"}`,
+		`{"region":{"+contains":"us"}}`,
+		`{"+order_by":"ipv4","label":"web"}`,
+	}
+
+	for _, input := range invalid {
+		if err := InstanceFilterSchema.ValidateString(input); err == nil {
+			t.Errorf("expected schema error for filter %s", input)
+		}
+	}
+}
+
+func TestListOptionsFilterSchema(t *testing.T) {
+	opts := &ListOptions{
+		Filter:       `{"ipv4":"192.0.2.1"}`,
+		FilterSchema: InstanceFilterSchema,
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.linode.com/v4/linode/instances", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := createListOptionsToRequestMutator(opts)(req); err == nil {
+		t.Fatal("expected filter to be rejected by schema")
+	}
+
+	opts.Filter = `{"label":"web"}`
+
+	if err := createListOptionsToRequestMutator(opts)(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if req.Header.Get("X-Filter") != opts.Filter {
+		t.Fatalf("expected X-Filter header to be set, got %q", req.Header.Get("X-Filter"))
+	}
+}
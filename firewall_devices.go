@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -69,6 +70,12 @@ func (c *Client) ListFirewallDevices(ctx context.Context, firewallID int, opts *
 	return getPaginatedResults[FirewallDevice](ctx, c, formatAPIPath("networking/firewalls/%d/devices", firewallID), opts)
 }
 
+// IterFirewallDevices returns an iterator over the results of ListFirewallDevices,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterFirewallDevices(ctx context.Context, firewallID int, opts *ListOptions) iter.Seq2[FirewallDevice, error] {
+	return getPaginatedIter[FirewallDevice](ctx, c, formatAPIPath("networking/firewalls/%d/devices", firewallID), opts)
+}
+
 // GetFirewallDevice gets a FirewallDevice given an ID
 func (c *Client) GetFirewallDevice(ctx context.Context, firewallID, deviceID int) (*FirewallDevice, error) {
 	e := formatAPIPath("networking/firewalls/%d/devices/%d", firewallID, deviceID)
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -118,6 +119,12 @@ func (c *Client) ListFirewallRuleSets(ctx context.Context, opts *ListOptions) ([
 	return getPaginatedResults[FirewallRuleSet](ctx, c, "networking/firewalls/rulesets", opts)
 }
 
+// IterFirewallRuleSets returns an iterator over the results of ListFirewallRuleSets,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterFirewallRuleSets(ctx context.Context, opts *ListOptions) iter.Seq2[FirewallRuleSet, error] {
+	return getPaginatedIter[FirewallRuleSet](ctx, c, "networking/firewalls/rulesets", opts)
+}
+
 // CreateFirewallRuleSet creates a new Rule Set.
 func (c *Client) CreateFirewallRuleSet(ctx context.Context, opts FirewallRuleSetCreateOptions) (*FirewallRuleSet, error) {
 	return doPOSTRequest[FirewallRuleSet](ctx, c, "networking/firewalls/rulesets", opts)
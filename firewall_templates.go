@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 type FirewallTemplate struct {
@@ -21,3 +22,9 @@ func (c *Client) GetFirewallTemplate(ctx context.Context, slug string) (*Firewal
 func (c *Client) ListFirewallTemplates(ctx context.Context, opts *ListOptions) ([]FirewallTemplate, error) {
 	return getPaginatedResults[FirewallTemplate](ctx, c, "networking/firewalls/templates", opts)
 }
+
+// IterFirewallTemplates returns an iterator over the results of ListFirewallTemplates,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterFirewallTemplates(ctx context.Context, opts *ListOptions) iter.Seq2[FirewallTemplate, error] {
+	return getPaginatedIter[FirewallTemplate](ctx, c, "networking/firewalls/templates", opts)
+}
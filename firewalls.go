@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -121,6 +122,12 @@ func (c *Client) ListFirewalls(ctx context.Context, opts *ListOptions) ([]Firewa
 	return getPaginatedResults[Firewall](ctx, c, "networking/firewalls", opts)
 }
 
+// IterFirewalls returns an iterator over the results of ListFirewalls,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterFirewalls(ctx context.Context, opts *ListOptions) iter.Seq2[Firewall, error] {
+	return getPaginatedIter[Firewall](ctx, c, "networking/firewalls", opts)
+}
+
 // CreateFirewall creates a single Firewall with at least one set of inbound or outbound rules
 func (c *Client) CreateFirewall(ctx context.Context, opts FirewallCreateOptions) (*Firewall, error) {
 	return doPOSTRequest[Firewall](ctx, c, "networking/firewalls", opts)
@@ -0,0 +1,172 @@
+package linodego
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+)
+
+// IdempotencyKeyHeader is the header used to send idempotency keys to the API.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKeyContextKey struct{}
+
+// idempotencyKey is an idempotency key set on a context, which is used by a single request.
+type idempotencyKey struct {
+	key  string
+	used atomic.Bool
+}
+
+// take returns the key if it has not been used by another request yet.
+func (k *idempotencyKey) take() (string, bool) {
+	return k.key, k.key != "" && k.used.CompareAndSwap(false, true)
+}
+
+// WithIdempotencyKey returns a copy of ctx that causes the next create (POST) request
+// made with it to send the given idempotency key.
+//
+// The key is only sent with the first POST request made using the returned context,
+// so other requests made with it, e.g. to boot the created instance, are not mistaken
+// for a repeat of the create. The same key is sent on every retry of that request, so
+// the API can recognise a request that was processed before its response was lost and
+// avoid creating a duplicate resource.
+//
+//	key := linodego.NewIdempotencyKey()
+//	instance, err := client.CreateInstance(linodego.WithIdempotencyKey(ctx, key), opts)
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, &idempotencyKey{key: key})
+}
+
+// IdempotencyKeyFromContext returns the idempotency key set on ctx using WithIdempotencyKey,
+// whether or not it has been sent.
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(*idempotencyKey)
+	if !ok || key.key == "" {
+		return "", false
+	}
+
+	return key.key, true
+}
+
+// takeIdempotencyKey returns the idempotency key set on ctx if it has not been used by another request.
+func takeIdempotencyKey(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(*idempotencyKey)
+	if !ok {
+		return "", false
+	}
+
+	return key.take()
+}
+
+// NewIdempotencyKey returns a new random idempotency key.
+func NewIdempotencyKey() string {
+	return rand.Text()
+}
+
+// SetAutoIdempotencyKeys sets whether the client generates an idempotency key for
+// each POST request that does not have one set using WithIdempotencyKey.
+// A generated key is reused across the retries of a single request.
+func (c *Client) SetAutoIdempotencyKeys(enabled bool) *Client {
+	c.autoIdempotencyKeys = enabled
+	return c
+}
+
+// idempotencyHeaders returns the headers that should be sent with a POST request
+// made using the given context, using up the idempotency key of the context.
+func (c *Client) idempotencyHeaders(ctx context.Context) http.Header {
+	key, ok := takeIdempotencyKey(ctx)
+
+	if !ok {
+		if !c.autoIdempotencyKeys {
+			return nil
+		}
+
+		key = NewIdempotencyKey()
+	}
+
+	return http.Header{IdempotencyKeyHeader: {key}}
+}
+
+// CreateWithLabelFallback creates a resource using create, guarding against duplicates
+// when the outcome of a create request is unknown.
+//
+// If create fails ambiguously, e.g. the request timed out or the API responded with a
+// server error, the resource may have been created regardless. In that case find is used
+// to look up a resource with the given label, which is returned if it exists. Otherwise,
+// create is called again. Both attempts share an idempotency key, which is sent with
+// the first POST request made by each call to create.
+//
+// The label must uniquely identify the resource, and find is typically a List method:
+//
+//	instance, err := linodego.CreateWithLabelFallback(ctx, opts.Label,
+//		func(ctx context.Context) (*linodego.Instance, error) {
+//			return client.CreateInstance(ctx, opts)
+//		},
+//		client.ListInstances,
+//	)
+func CreateWithLabelFallback[T any](
+	ctx context.Context,
+	label string,
+	create func(ctx context.Context) (*T, error),
+	find func(ctx context.Context, opts *ListOptions) ([]T, error),
+) (*T, error) {
+	if label == "" {
+		return nil, fmt.Errorf("a label is required to look up an existing resource")
+	}
+
+	key, ok := takeIdempotencyKey(ctx)
+	if !ok {
+		key = NewIdempotencyKey()
+	}
+
+	result, err := create(WithIdempotencyKey(ctx, key))
+	if err == nil || !isAmbiguousCreateError(err) || ctx.Err() != nil {
+		return result, err
+	}
+
+	filter := Filter{}
+	filter.AddField(Eq, "label", label)
+
+	filterJSON, filterErr := filter.MarshalJSON()
+	if filterErr != nil {
+		return nil, filterErr
+	}
+
+	existing, findErr := find(ctx, NewListOptions(0, string(filterJSON)))
+	if findErr != nil {
+		return nil, fmt.Errorf("failed to look up resource with label %q after create failed: %w (create error: %v)",
+			label, findErr, err)
+	}
+
+	switch len(existing) {
+	case 0:
+		return create(WithIdempotencyKey(ctx, key))
+	case 1:
+		return &existing[0], nil
+	default:
+		return nil, fmt.Errorf("found %d resources with label %q after create failed: %w", len(existing), label, err)
+	}
+}
+
+// isAmbiguousCreateError returns whether a create request that failed with err
+// may have been processed by the API.
+func isAmbiguousCreateError(err error) bool {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == http.StatusRequestTimeout || apiErr.Code >= http.StatusInternalServerError
+	}
+
+	// The request may have been sent before the connection failed or timed out,
+	// while other errors, e.g. failing to build the request, happen before it is sent
+	var (
+		urlErr *url.Error
+		netErr net.Error
+	)
+
+	return errors.As(err, &urlErr) || errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded)
+}
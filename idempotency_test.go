@@ -0,0 +1,179 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_IdempotencyKeyStableAcrossRetries(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests int
+		keys     []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		attempt := requests
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		// Fail the first attempt of each request
+		if attempt%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "unavailable"}]}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": 123}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond)
+
+	_, err := client.CreateVolume(WithIdempotencyKey(context.Background(), "test-key"), VolumeCreateOptions{Label: "test"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"test-key", "test-key"}, keys)
+
+	keys = nil
+
+	// Keys are only sent when requested
+	_, err = client.CreateVolume(context.Background(), VolumeCreateOptions{Label: "test"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"", ""}, keys)
+
+	keys = nil
+
+	client.SetAutoIdempotencyKeys(true)
+
+	_, err = client.CreateVolume(context.Background(), VolumeCreateOptions{Label: "test"})
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.NotEmpty(t, keys[0])
+	require.Equal(t, keys[0], keys[1])
+}
+
+func TestClient_IdempotencyKeyUsedOnce(t *testing.T) {
+	var keys []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL)
+
+	ctx := WithIdempotencyKey(context.Background(), "test-key")
+
+	_, err := client.CreateVolume(ctx, VolumeCreateOptions{Label: "test"})
+	require.NoError(t, err)
+
+	// Later requests made with the context are not a repeat of the create
+	require.NoError(t, client.ResizeVolume(ctx, 123, VolumeResizeOptions{Size: 20}))
+	require.Equal(t, []string{"test-key", ""}, keys)
+
+	key, ok := IdempotencyKeyFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, "test-key", key)
+}
+
+func TestIsAmbiguousCreateError(t *testing.T) {
+	require.True(t, isAmbiguousCreateError(&Error{Code: http.StatusBadGateway}))
+	require.True(t, isAmbiguousCreateError(&url.Error{Op: "Post", URL: "https://api.linode.com", Err: io.EOF}))
+	require.True(t, isAmbiguousCreateError(fmt.Errorf("failed: %w", context.DeadlineExceeded)))
+
+	require.False(t, isAmbiguousCreateError(&Error{Code: http.StatusBadRequest}))
+	require.False(t, isAmbiguousCreateError(errors.New("failed to create request")))
+}
+
+func TestCreateWithLabelFallback(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		createCalls int
+		findCalls   int
+		createKeys  []string
+	)
+
+	ambiguousErr := &Error{Code: http.StatusGatewayTimeout, Message: "timeout"}
+
+	create := func(err error) func(ctx context.Context) (*Volume, error) {
+		return func(ctx context.Context) (*Volume, error) {
+			createCalls++
+
+			key, _ := IdempotencyKeyFromContext(ctx)
+			createKeys = append(createKeys, key)
+
+			if createCalls == 1 && err != nil {
+				return nil, err
+			}
+
+			return &Volume{ID: 2, Label: "data"}, nil
+		}
+	}
+
+	find := func(existing ...Volume) func(ctx context.Context, opts *ListOptions) ([]Volume, error) {
+		return func(ctx context.Context, opts *ListOptions) ([]Volume, error) {
+			findCalls++
+
+			require.JSONEq(t, `{"label": "data"}`, opts.Filter)
+
+			return existing, nil
+		}
+	}
+
+	reset := func() {
+		createCalls, findCalls, createKeys = 0, 0, nil
+	}
+
+	// The existing resource is returned after an ambiguous failure
+	volume, err := CreateWithLabelFallback(ctx, "data", create(ambiguousErr), find(Volume{ID: 1, Label: "data"}))
+	require.NoError(t, err)
+	require.Equal(t, 1, volume.ID)
+	require.Equal(t, 1, createCalls)
+	require.Equal(t, 1, findCalls)
+
+	reset()
+
+	// The resource is created again if it does not exist
+	volume, err = CreateWithLabelFallback(ctx, "data", create(ambiguousErr), find())
+	require.NoError(t, err)
+	require.Equal(t, 2, volume.ID)
+	require.Equal(t, 2, createCalls)
+	require.Len(t, createKeys, 2)
+	require.NotEmpty(t, createKeys[0])
+	require.Equal(t, createKeys[0], createKeys[1])
+
+	reset()
+
+	// Client errors are returned without a lookup
+	_, err = CreateWithLabelFallback(ctx, "data", create(&Error{Code: http.StatusBadRequest}), find())
+	require.True(t, ErrHasStatus(err, http.StatusBadRequest))
+	require.Zero(t, findCalls)
+
+	reset()
+
+	// Duplicate labels can't be resolved
+	_, err = CreateWithLabelFallback(ctx, "data", create(ambiguousErr), find(Volume{ID: 1}, Volume{ID: 3}))
+	require.ErrorContains(t, err, "found 2 resources")
+	require.ErrorIs(t, err, ambiguousErr)
+}
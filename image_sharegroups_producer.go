@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -139,6 +140,20 @@ func (c *Client) ListImageShareGroups(
 	)
 }
 
+// IterImageShareGroups returns an iterator over the results of ListImageShareGroups,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterImageShareGroups(
+	ctx context.Context,
+	opts *ListOptions,
+) iter.Seq2[ProducerImageShareGroup, error] {
+	return getPaginatedIter[ProducerImageShareGroup](
+		ctx,
+		c,
+		"images/sharegroups",
+		opts,
+	)
+}
+
 // ListImageShareGroupsContainingPrivateImage lists all current ImageShareGroups owned by the producer where
 // the given private image is present.
 // NOTE: May not currently be available to all users and can only be used with v4beta.
@@ -155,6 +170,21 @@ func (c *Client) ListImageShareGroupsContainingPrivateImage(
 	)
 }
 
+// IterImageShareGroupsContainingPrivateImage returns an iterator over the results of ListImageShareGroupsContainingPrivateImage,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterImageShareGroupsContainingPrivateImage(
+	ctx context.Context,
+	privateImageID string,
+	opts *ListOptions,
+) iter.Seq2[ProducerImageShareGroup, error] {
+	return getPaginatedIter[ProducerImageShareGroup](
+		ctx,
+		c,
+		formatAPIPath("images/%s/sharegroups", privateImageID),
+		opts,
+	)
+}
+
 // GetImageShareGroup gets the specified ImageShareGroup owned by the producer.
 // NOTE: May not currently be available to all users and can only be used with v4beta.
 func (c *Client) GetImageShareGroup(
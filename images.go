@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"iter"
 	"net/http"
 	"time"
 
@@ -232,6 +233,17 @@ func (c *Client) ListImages(ctx context.Context, opts *ListOptions) ([]Image, er
 	)
 }
 
+// IterImages returns an iterator over the results of ListImages,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterImages(ctx context.Context, opts *ListOptions) iter.Seq2[Image, error] {
+	return getPaginatedIter[Image](
+		ctx,
+		c,
+		"images",
+		opts,
+	)
+}
+
 // GetImage gets the Image with the provided ID.
 func (c *Client) GetImage(ctx context.Context, imageID string) (*Image, error) {
 	return doGETRequest[Image](
@@ -296,6 +308,7 @@ func (c *Client) CreateImageUpload(ctx context.Context, opts ImageCreateUploadOp
 }
 
 // UploadImageToURL uploads the given image to the given upload URL.
+// The progress of the upload is reported to the ProgressFunc of ctx, if any (see WithProgress).
 func (c *Client) UploadImageToURL(ctx context.Context, uploadURL string, image io.Reader) error {
 	clonedClient := *c.httpClient
 	clonedClient.Transport = http.DefaultTransport
@@ -316,7 +329,13 @@ func (c *Client) UploadImageToURL(ctx context.Context, uploadURL string, image i
 		contentLength = size
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, image)
+	body := image
+
+	if reporter := newProgressReporter(ctx); reporter != nil {
+		body = &progressReader{Reader: image, reporter: reporter, total: contentLength}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, body)
 	if err != nil {
 		return err
 	}
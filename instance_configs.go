@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -225,6 +226,12 @@ func (c *Client) ListInstanceConfigs(ctx context.Context, linodeID int, opts *Li
 	return getPaginatedResults[InstanceConfig](ctx, c, formatAPIPath("linode/instances/%d/configs", linodeID), opts)
 }
 
+// IterInstanceConfigs returns an iterator over the results of ListInstanceConfigs,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterInstanceConfigs(ctx context.Context, linodeID int, opts *ListOptions) iter.Seq2[InstanceConfig, error] {
+	return getPaginatedIter[InstanceConfig](ctx, c, formatAPIPath("linode/instances/%d/configs", linodeID), opts)
+}
+
 // GetInstanceConfig gets the template with the provided ID
 func (c *Client) GetInstanceConfig(ctx context.Context, linodeID int, configID int) (*InstanceConfig, error) {
 	e := formatAPIPath("linode/instances/%d/configs/%d", linodeID, configID)
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -78,6 +79,12 @@ func (c *Client) ListInstanceDisks(ctx context.Context, linodeID int, opts *List
 	return getPaginatedResults[InstanceDisk](ctx, c, formatAPIPath("linode/instances/%d/disks", linodeID), opts)
 }
 
+// IterInstanceDisks returns an iterator over the results of ListInstanceDisks,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterInstanceDisks(ctx context.Context, linodeID int, opts *ListOptions) iter.Seq2[InstanceDisk, error] {
+	return getPaginatedIter[InstanceDisk](ctx, c, formatAPIPath("linode/instances/%d/disks", linodeID), opts)
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (i *InstanceDisk) UnmarshalJSON(b []byte) error {
 	type Mask InstanceDisk
@@ -145,7 +152,25 @@ func (c *Client) DeleteInstanceDisk(ctx context.Context, linodeID int, diskID in
 }
 
 // CloneInstanceDisk clones the given InstanceDisk for the given Instance
+// Use WaitForInstanceDiskClone to wait for the clone to finish and report its progress.
 func (c *Client) CloneInstanceDisk(ctx context.Context, linodeID, diskID int) (*InstanceDisk, error) {
 	e := formatAPIPath("linode/instances/%d/disks/%d/clone", linodeID, diskID)
 	return doPOSTRequestNoRequestBody[InstanceDisk](ctx, c, e)
 }
+
+// CloneInstanceDiskOp clones a disk like CloneInstanceDisk,
+// returning the new disk and an Operation that tracks the clone.
+// The progress of the clone is reported while waiting for the Operation (see WithProgress).
+func (c *Client) CloneInstanceDiskOp(ctx context.Context, linodeID, diskID int) (*InstanceDisk, *Operation, error) {
+	var disk *InstanceDisk
+
+	op, err := c.startOperation(ctx, EntityLinode, linodeID, ActionDiskDuplicate, func() (err error) {
+		disk, err = c.CloneInstanceDisk(ctx, linodeID, diskID)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return disk, op, nil
+}
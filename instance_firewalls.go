@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // ListInstanceFirewalls returns a paginated list of Cloud Firewalls for linodeID
@@ -9,6 +10,12 @@ func (c *Client) ListInstanceFirewalls(ctx context.Context, linodeID int, opts *
 	return getPaginatedResults[Firewall](ctx, c, formatAPIPath("linode/instances/%d/firewalls", linodeID), opts)
 }
 
+// IterInstanceFirewalls returns an iterator over the results of ListInstanceFirewalls,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterInstanceFirewalls(ctx context.Context, linodeID int, opts *ListOptions) iter.Seq2[Firewall, error] {
+	return getPaginatedIter[Firewall](ctx, c, formatAPIPath("linode/instances/%d/firewalls", linodeID), opts)
+}
+
 type InstanceFirewallUpdateOptions struct {
 	FirewallIDs []int `json:"firewall_ids"`
 }
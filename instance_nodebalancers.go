@@ -2,9 +2,16 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // ListInstanceNodeBalancers lists NodeBalancers that the provided instance is a node in
 func (c *Client) ListInstanceNodeBalancers(ctx context.Context, linodeID int, opts *ListOptions) ([]NodeBalancer, error) {
 	return getPaginatedResults[NodeBalancer](ctx, c, formatAPIPath("linode/instances/%d/nodebalancers", linodeID), opts)
 }
+
+// IterInstanceNodeBalancers returns an iterator over the results of ListInstanceNodeBalancers,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterInstanceNodeBalancers(ctx context.Context, linodeID int, opts *ListOptions) iter.Seq2[NodeBalancer, error] {
+	return getPaginatedIter[NodeBalancer](ctx, c, formatAPIPath("linode/instances/%d/nodebalancers", linodeID), opts)
+}
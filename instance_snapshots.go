@@ -127,3 +127,18 @@ func (c *Client) RestoreInstanceBackup(ctx context.Context, linodeID int, backup
 	e := formatAPIPath("linode/instances/%d/backups/%d/restore", linodeID, backupID)
 	return doPOSTRequestNoResponseBody(ctx, c, e, opts)
 }
+
+// RestoreInstanceBackupOp restores a backup like RestoreInstanceBackup,
+// returning an Operation that tracks the restore on the target Linode.
+func (c *Client) RestoreInstanceBackupOp(
+	ctx context.Context, linodeID int, backupID int, opts RestoreInstanceOptions,
+) (*Operation, error) {
+	targetID := opts.LinodeID
+	if targetID == 0 {
+		targetID = linodeID
+	}
+
+	return c.startOperation(ctx, EntityLinode, targetID, ActionBackupsRestore, func() error {
+		return c.RestoreInstanceBackup(ctx, linodeID, backupID, opts)
+	})
+}
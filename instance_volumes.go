@@ -2,9 +2,16 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // ListInstanceVolumes lists InstanceVolumes
 func (c *Client) ListInstanceVolumes(ctx context.Context, linodeID int, opts *ListOptions) ([]Volume, error) {
 	return getPaginatedResults[Volume](ctx, c, formatAPIPath("linode/instances/%d/volumes", linodeID), opts)
 }
+
+// IterInstanceVolumes returns an iterator over the results of ListInstanceVolumes,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterInstanceVolumes(ctx context.Context, linodeID int, opts *ListOptions) iter.Seq2[Volume, error] {
+	return getPaginatedIter[Volume](ctx, c, formatAPIPath("linode/instances/%d/volumes", linodeID), opts)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net"
 	"time"
 
@@ -402,6 +403,12 @@ func (c *Client) ListInstances(ctx context.Context, opts *ListOptions) ([]Instan
 	return getPaginatedResults[Instance](ctx, c, "linode/instances", opts)
 }
 
+// IterInstances returns an iterator over the results of ListInstances,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterInstances(ctx context.Context, opts *ListOptions) iter.Seq2[Instance, error] {
+	return getPaginatedIter[Instance](ctx, c, "linode/instances", opts)
+}
+
 // GetInstance gets the instance with the provided ID
 func (c *Client) GetInstance(ctx context.Context, linodeID int) (*Instance, error) {
 	e := formatAPIPath("linode/instances/%d", linodeID)
@@ -450,12 +457,36 @@ func (c *Client) BootInstance(ctx context.Context, linodeID int, opts InstanceBo
 	return doPOSTRequestNoResponseBody(ctx, c, e, opts)
 }
 
+// BootInstanceOp boots a Linode instance like BootInstance,
+// returning an Operation that tracks the boot.
+func (c *Client) BootInstanceOp(ctx context.Context, linodeID int, opts InstanceBootOptions) (*Operation, error) {
+	return c.startOperation(ctx, EntityLinode, linodeID, ActionLinodeBoot, func() error {
+		return c.BootInstance(ctx, linodeID, opts)
+	})
+}
+
 // CloneInstance clone an existing Instances Disks and Configuration profiles to another Linode Instance
 func (c *Client) CloneInstance(ctx context.Context, linodeID int, opts InstanceCloneOptions) (*Instance, error) {
 	e := formatAPIPath("linode/instances/%d/clone", linodeID)
 	return doPOSTRequest[Instance](ctx, c, e, opts)
 }
 
+// CloneInstanceOp clones an instance like CloneInstance,
+// returning the new instance and an Operation that tracks the clone.
+func (c *Client) CloneInstanceOp(ctx context.Context, linodeID int, opts InstanceCloneOptions) (*Instance, *Operation, error) {
+	var instance *Instance
+
+	op, err := c.startOperation(ctx, EntityLinode, linodeID, ActionLinodeClone, func() (err error) {
+		instance, err = c.CloneInstance(ctx, linodeID, opts)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return instance, op, nil
+}
+
 // ResetInstancePassword resets a Linode instance's root password
 func (c *Client) ResetInstancePassword(ctx context.Context, linodeID int, opts InstancePasswordResetOptions) error {
 	e := formatAPIPath("linode/instances/%d/password", linodeID)
@@ -511,6 +542,14 @@ func (c *Client) ResizeInstance(ctx context.Context, linodeID int, opts Instance
 	return doPOSTRequestNoResponseBody(ctx, c, e, opts)
 }
 
+// ResizeInstanceOp resizes an instance like ResizeInstance,
+// returning an Operation that tracks the resize.
+func (c *Client) ResizeInstanceOp(ctx context.Context, linodeID int, opts InstanceResizeOptions) (*Operation, error) {
+	return c.startOperation(ctx, EntityLinode, linodeID, ActionLinodeResize, func() error {
+		return c.ResizeInstance(ctx, linodeID, opts)
+	})
+}
+
 // ShutdownInstance - Shutdown an instance
 func (c *Client) ShutdownInstance(ctx context.Context, id int) error {
 	return c.simpleInstanceAction(ctx, "shutdown", id)
@@ -535,6 +574,19 @@ func (c *Client) MigrateInstance(ctx context.Context, linodeID int, opts Instanc
 	return doPOSTRequestNoResponseBody(ctx, c, e, opts)
 }
 
+// MigrateInstanceOp migrates an instance like MigrateInstance,
+// returning an Operation that tracks the migration.
+func (c *Client) MigrateInstanceOp(ctx context.Context, linodeID int, opts InstanceMigrateOptions) (*Operation, error) {
+	action := ActionLinodeMigrate
+	if opts.Region != "" {
+		action = ActionLinodeMigrateDatacenter
+	}
+
+	return c.startOperation(ctx, EntityLinode, linodeID, action, func() error {
+		return c.MigrateInstance(ctx, linodeID, opts)
+	})
+}
+
 // simpleInstanceAction is a helper for Instance actions that take no parameters
 // and return empty responses `{}` unless they return a standard error
 func (c *Client) simpleInstanceAction(ctx context.Context, action string, linodeID int) error {
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -268,6 +269,13 @@ func (c *Client) ListInterfaces(ctx context.Context, linodeID int, opts *ListOpt
 	return getPaginatedResults[LinodeInterface](ctx, c, e, opts)
 }
 
+// IterInterfaces returns an iterator over the results of ListInterfaces,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterInterfaces(ctx context.Context, linodeID int, opts *ListOptions) iter.Seq2[LinodeInterface, error] {
+	e := formatAPIPath("linode/instances/%d/interfaces", linodeID)
+	return getPaginatedIter[LinodeInterface](ctx, c, e, opts)
+}
+
 func (c *Client) GetInterface(ctx context.Context, linodeID int, interfaceID int) (*LinodeInterface, error) {
 	e := formatAPIPath("linode/instances/%d/interfaces/%d", linodeID, interfaceID)
 	return doGETRequest[LinodeInterface](ctx, c, e)
@@ -298,6 +306,13 @@ func (c *Client) ListInterfaceFirewalls(ctx context.Context, linodeID int, inter
 	return getPaginatedResults[Firewall](ctx, c, e, opts)
 }
 
+// IterInterfaceFirewalls returns an iterator over the results of ListInterfaceFirewalls,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterInterfaceFirewalls(ctx context.Context, linodeID int, interfaceID int, opts *ListOptions) iter.Seq2[Firewall, error] {
+	e := formatAPIPath("linode/instances/%d/interfaces/%d/firewalls", linodeID, interfaceID)
+	return getPaginatedIter[Firewall](ctx, c, e, opts)
+}
+
 func (c *Client) GetInterfaceSettings(ctx context.Context, linodeID int) (*InterfaceSettings, error) {
 	e := formatAPIPath("linode/instances/%d/interfaces/settings", linodeID)
 	return doGETRequest[InterfaceSettings](ctx, c, e)
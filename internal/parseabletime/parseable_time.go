@@ -20,3 +20,7 @@ func (p *ParseableTime) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+func (p ParseableTime) MarshalJSON() ([]byte, error) {
+	return []byte(time.Time(p).Format(`"` + dateLayout + `"`)), nil
+}
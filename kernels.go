@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -41,6 +42,22 @@ func (i *LinodeKernel) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaler interface
+func (i LinodeKernel) MarshalJSON() ([]byte, error) {
+	type Mask LinodeKernel
+
+	p := struct {
+		Mask
+
+		Built *parseabletime.ParseableTime `json:"built,omitempty"`
+	}{
+		Mask:  Mask(i),
+		Built: (*parseabletime.ParseableTime)(i.Built),
+	}
+
+	return json.Marshal(p)
+}
+
 // ListKernels lists linode kernels. This endpoint is cached by default.
 func (c *Client) ListKernels(ctx context.Context, opts *ListOptions) ([]LinodeKernel, error) {
 	endpoint, err := generateListCacheURL("linode/kernels", opts)
@@ -48,8 +65,8 @@ func (c *Client) ListKernels(ctx context.Context, opts *ListOptions) ([]LinodeKe
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]LinodeKernel), nil
+	if result := getCachedResponse[[]LinodeKernel](ctx, c, endpoint); result != nil {
+		return *result, nil
 	}
 
 	response, err := getPaginatedResults[LinodeKernel](ctx, c, "linode/kernels", opts)
@@ -57,18 +74,23 @@ func (c *Client) ListKernels(ctx context.Context, opts *ListOptions) ([]LinodeKe
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, nil)
+	c.addCachedResponse(ctx, endpoint, response, nil)
 
 	return response, nil
 }
 
+// IterKernels returns an iterator over the results of ListKernels,
+// fetching pages lazily as they are consumed. Unlike ListKernels, results are not cached.
+func (c *Client) IterKernels(ctx context.Context, opts *ListOptions) iter.Seq2[LinodeKernel, error] {
+	return getPaginatedIter[LinodeKernel](ctx, c, "linode/kernels", opts)
+}
+
 // GetKernel gets the kernel with the provided ID. This endpoint is cached by default.
 func (c *Client) GetKernel(ctx context.Context, kernelID string) (*LinodeKernel, error) {
 	e := formatAPIPath("linode/kernels/%s", kernelID)
 
-	if result := c.getCachedResponse(e); result != nil {
-		result := result.(LinodeKernel)
-		return &result, nil
+	if result := getCachedResponse[LinodeKernel](ctx, c, e); result != nil {
+		return result, nil
 	}
 
 	response, err := doGETRequest[LinodeKernel](ctx, c, e)
@@ -76,7 +98,7 @@ func (c *Client) GetKernel(ctx context.Context, kernelID string) (*LinodeKernel,
 		return nil, err
 	}
 
-	c.addCachedResponse(e, response, nil)
+	c.addCachedResponse(ctx, e, response, nil)
 
 	return response, nil
 }
@@ -0,0 +1,172 @@
+package linodegotest
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/linode/linodego/v2"
+)
+
+// filterObjects returns the objects matching the given X-Filter, ordered as requested.
+// If the filter does not specify an order, objects are ordered by ID.
+func filterObjects(objects []object, filter string, descending bool) ([]object, error) {
+	f := &linodego.Filter{}
+
+	if filter != "" {
+		parsed, err := linodego.ParseFilter(filter)
+		if err != nil {
+			return nil, errBadRequest("X-Filter", "invalid filter: %s", err)
+		}
+
+		f = parsed
+	}
+
+	result := make([]object, 0, len(objects))
+
+	for _, obj := range objects {
+		if matchFilterNode(obj, f) {
+			result = append(result, obj)
+		}
+	}
+
+	orderBy := f.OrderBy
+	if orderBy == "" {
+		orderBy = "id"
+	}
+
+	switch f.Order {
+	case linodego.Ascending:
+		descending = false
+	case linodego.Descending:
+		descending = true
+	}
+
+	slices.SortStableFunc(result, func(a, b object) int {
+		order := compareValues(lookupField(a, orderBy), lookupField(b, orderBy))
+		if order == 0 {
+			order = compareValues(a["id"], b["id"])
+		}
+
+		if descending {
+			return -order
+		}
+
+		return order
+	})
+
+	return result, nil
+}
+
+func matchFilterNode(obj object, node linodego.FilterNode) bool {
+	switch n := node.(type) {
+	case *linodego.Filter:
+		if n.Operator == "+or" {
+			return slices.ContainsFunc(n.Children, func(c linodego.FilterNode) bool {
+				return matchFilterNode(obj, c)
+			})
+		}
+
+		for _, c := range n.Children {
+			if !matchFilterNode(obj, c) {
+				return false
+			}
+		}
+
+		return true
+	case *linodego.Comp:
+		return matchComp(lookupField(obj, n.Column), n.Operator, n.Value)
+	default:
+		return false
+	}
+}
+
+func matchComp(value any, op linodego.FilterOperator, expected any) bool {
+	// Filtering a list field (e.g. tags) matches if any element matches
+	if list, ok := value.([]any); ok {
+		if op == linodego.Neq {
+			return !slices.ContainsFunc(list, func(v any) bool {
+				return matchComp(v, linodego.Eq, expected)
+			})
+		}
+
+		return slices.ContainsFunc(list, func(v any) bool {
+			return matchComp(v, op, expected)
+		})
+	}
+
+	switch op {
+	case linodego.Eq:
+		return compareValues(value, expected) == 0
+	case linodego.Neq:
+		return compareValues(value, expected) != 0
+	case linodego.Gt:
+		return value != nil && compareValues(value, expected) > 0
+	case linodego.Gte:
+		return value != nil && compareValues(value, expected) >= 0
+	case linodego.Lt:
+		return value != nil && compareValues(value, expected) < 0
+	case linodego.Lte:
+		return value != nil && compareValues(value, expected) <= 0
+	case linodego.Contains:
+		return strings.Contains(
+			strings.ToLower(fmt.Sprint(value)),
+			strings.ToLower(fmt.Sprint(expected)),
+		)
+	default:
+		return false
+	}
+}
+
+// lookupField resolves a dotted key such as "entity.id" within the given object.
+func lookupField(obj object, key string) any {
+	var current any = obj
+
+	for part := range strings.SplitSeq(key, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		current = m[part]
+	}
+
+	return current
+}
+
+// compareValues compares two JSON values, treating numbers of any type as equal
+// if they have the same value. Values of different kinds are compared as strings.
+func compareValues(a, b any) int {
+	if af, ok := toFloat(a); ok {
+		if bf, ok := toFloat(b); ok {
+			return cmp.Compare(af, bf)
+		}
+	}
+
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,95 @@
+package recorder
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CassetteVersion is the version of the fixture format written by the recorder.
+const CassetteVersion = 1
+
+// Cassette is a set of recorded HTTP interactions, stored in the same YAML
+// format as the linodego integration test fixtures.
+type Cassette struct {
+	Version      int            `yaml:"version"`
+	Interactions []*Interaction `yaml:"interactions"`
+}
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	Request  Request  `yaml:"request"`
+	Response Response `yaml:"response"`
+
+	replayed bool
+}
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Body    string      `yaml:"body"`
+	Form    url.Values  `yaml:"form"`
+	Headers http.Header `yaml:"headers"`
+	URL     string      `yaml:"url"`
+	Method  string      `yaml:"method"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	Body     string      `yaml:"body"`
+	Headers  http.Header `yaml:"headers"`
+	Status   string      `yaml:"status"`
+	Code     int         `yaml:"code"`
+	Duration string      `yaml:"duration"`
+}
+
+// LoadCassette reads the cassette at the given path.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	var cassette Cassette
+	if err := yaml.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+	}
+
+	return &cassette, nil
+}
+
+// Save writes the cassette to the given path, creating parent directories as needed.
+func (c *Cassette) Save(path string) error {
+	if c.Version == 0 {
+		c.Version = CassetteVersion
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteString("---\n")
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(c); err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	return nil
+}
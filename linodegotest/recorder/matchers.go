@@ -0,0 +1,61 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+// Matcher reports whether a request matches a recorded request.
+// body is the request's body, which has already been read.
+type Matcher func(req *http.Request, body []byte, recorded Request) bool
+
+// DefaultMatchers match requests on their method, URL and X-Filter header.
+var DefaultMatchers = []Matcher{MatchMethod, MatchURL, MatchFilterHeader}
+
+// MatchMethod matches requests with the same HTTP method.
+func MatchMethod(req *http.Request, _ []byte, recorded Request) bool {
+	return req.Method == recorded.Method
+}
+
+// MatchURL matches requests with the same URL, ignoring the order of query parameters.
+func MatchURL(req *http.Request, _ []byte, recorded Request) bool {
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	return req.URL.Scheme == recordedURL.Scheme &&
+		req.URL.Host == recordedURL.Host &&
+		req.URL.Path == recordedURL.Path &&
+		req.URL.Query().Encode() == recordedURL.Query().Encode()
+}
+
+// MatchFilterHeader matches requests with semantically equal X-Filter headers.
+func MatchFilterHeader(req *http.Request, _ []byte, recorded Request) bool {
+	return jsonEqual([]byte(req.Header.Get("X-Filter")), []byte(recorded.Headers.Get("X-Filter")))
+}
+
+// MatchBody matches requests with semantically equal JSON bodies,
+// or identical bodies if they are not JSON.
+func MatchBody(_ *http.Request, body []byte, recorded Request) bool {
+	return jsonEqual(body, []byte(recorded.Body))
+}
+
+func jsonEqual(a, b []byte) bool {
+	a, b = bytes.TrimSpace(a), bytes.TrimSpace(b)
+
+	if bytes.Equal(a, b) {
+		return true
+	}
+
+	var aValue, bValue any
+
+	if json.Unmarshal(a, &aValue) != nil || json.Unmarshal(b, &bValue) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aValue, bValue)
+}
@@ -0,0 +1,273 @@
+// Package recorder provides an http.RoundTripper that records real Linode API
+// interactions to YAML fixtures and replays them offline.
+//
+// Fixtures use the same format as the linodego integration test fixtures,
+// so existing fixtures can be replayed and new fixtures can be shared between
+// test suites.
+//
+//	rec, err := recorder.New("fixtures/TestCreateInstance.yaml", recorder.Options{Mode: recorder.ModeReplaying})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//
+//	client, err := linodego.NewClient(&http.Client{Transport: rec})
+package recorder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mode determines whether a Recorder records or replays interactions.
+type Mode int
+
+const (
+	// ModeReplaying replays interactions from an existing cassette.
+	// Requests that do not match a recorded interaction fail.
+	ModeReplaying Mode = iota
+
+	// ModeRecording sends requests to the real transport and records
+	// the interactions, writing them to the cassette when stopped.
+	ModeRecording
+)
+
+// Options configures a Recorder.
+type Options struct {
+	// Mode determines whether interactions are recorded or replayed.
+	Mode Mode
+
+	// Matchers determine whether a request matches a recorded interaction.
+	// All matchers must match. Defaults to DefaultMatchers.
+	Matchers []Matcher
+
+	// Scrubbers are applied to each interaction before it is saved, and can be
+	// used to remove tokens and secrets. Defaults to DefaultScrubbers.
+	Scrubbers []Scrubber
+
+	// Transport is used to send requests when recording.
+	// Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+}
+
+// Recorder is an http.RoundTripper that records or replays API interactions.
+// A Recorder is safe for concurrent use.
+type Recorder struct {
+	path    string
+	options Options
+
+	mu       sync.Mutex
+	cassette *Cassette
+}
+
+var _ http.RoundTripper = (*Recorder)(nil)
+
+// UnmatchedRequestError is returned when replaying a request that does not
+// match any unused interaction in the cassette.
+type UnmatchedRequestError struct {
+	Cassette string
+	Method   string
+	URL      string
+	Filter   string
+}
+
+func (e *UnmatchedRequestError) Error() string {
+	msg := fmt.Sprintf("recorder: no unused interaction in %s matches %s %s", e.Cassette, e.Method, e.URL)
+	if e.Filter != "" {
+		msg += fmt.Sprintf(" (X-Filter: %s)", e.Filter)
+	}
+
+	return msg
+}
+
+// New creates a Recorder for the cassette at the given path.
+// When replaying, the cassette must already exist.
+func New(path string, options Options) (*Recorder, error) {
+	if options.Matchers == nil {
+		options.Matchers = DefaultMatchers
+	}
+
+	if options.Scrubbers == nil {
+		options.Scrubbers = DefaultScrubbers
+	}
+
+	if options.Transport == nil {
+		options.Transport = http.DefaultTransport
+	}
+
+	r := &Recorder{
+		path:    path,
+		options: options,
+	}
+
+	switch options.Mode {
+	case ModeReplaying:
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+
+		r.cassette = cassette
+	case ModeRecording:
+		r.cassette = &Cassette{Version: CassetteVersion}
+	default:
+		return nil, fmt.Errorf("recorder: unknown mode %d", options.Mode)
+	}
+
+	return r, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.options.Mode
+}
+
+// Stop finishes the recording session, writing the cassette if recording.
+func (r *Recorder) Stop() error {
+	if r.options.Mode != ModeRecording {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(r.path)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.options.Mode == ModeRecording {
+		return r.record(req, body)
+	}
+
+	return r.replay(req, body)
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, interaction := range r.cassette.Interactions {
+		if interaction.replayed || !r.matches(req, body, interaction.Request) {
+			continue
+		}
+
+		interaction.replayed = true
+
+		return interaction.Response.toHTTP(req), nil
+	}
+
+	return nil, &UnmatchedRequestError{
+		Cassette: r.path,
+		Method:   req.Method,
+		URL:      req.URL.String(),
+		Filter:   req.Header.Get("X-Filter"),
+	}
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	start := time.Now()
+
+	resp, err := r.options.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("recorder: failed to read response body: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: Request{
+			Body:    string(body),
+			Form:    map[string][]string{},
+			Headers: req.Header.Clone(),
+			URL:     req.URL.String(),
+			Method:  req.Method,
+		},
+		Response: Response{
+			Body:     string(respBody),
+			Headers:  resp.Header.Clone(),
+			Status:   resp.Status,
+			Code:     resp.StatusCode,
+			Duration: time.Since(start).String(),
+		},
+	}
+
+	for _, scrub := range r.options.Scrubbers {
+		scrub(interaction)
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) matches(req *http.Request, body []byte, recorded Request) bool {
+	for _, match := range r.options.Matchers {
+		if !match(req, body, recorded) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r Response) toHTTP(req *http.Request) *http.Response {
+	status := r.Status
+	if status == "" {
+		status = fmt.Sprintf("%d %s", r.Code, http.StatusText(r.Code))
+	}
+
+	header := r.Headers.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        status,
+		StatusCode:    r.Code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// readRequestBody reads the body of the request, replacing it so it can be read again.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("recorder: failed to read request body: %w", err)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
@@ -0,0 +1,160 @@
+package recorder
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T, transport http.RoundTripper, baseURL string) *linodego.Client {
+	t.Helper()
+
+	client, err := linodego.NewClient(&http.Client{Transport: transport})
+	require.NoError(t, err)
+
+	client.SetBaseURL(baseURL).SetToken("super-secret-token").SetRetryCount(0)
+
+	return &client
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+
+		switch {
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":123,"label":"test","root_pass":"hunter2"}`))
+		case r.Header.Get("X-Filter") != "":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data":[{"id":123,"label":"test"}],"page":1,"pages":1,"results":1}`))
+		default:
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data":[{"id":123,"label":"test"},{"id":456,"label":"other"}],"page":1,"pages":1,"results":2}`))
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "fixtures", "TestRecorder.yaml")
+	ctx := context.Background()
+
+	rec, err := New(path, Options{Mode: ModeRecording})
+	require.NoError(t, err)
+
+	client := newClient(t, rec, server.URL)
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{Region: "us-east", RootPass: "hunter2"})
+	require.NoError(t, err)
+	require.Equal(t, 123, instance.ID)
+
+	instances, err := client.ListInstances(ctx, linodego.NewListOptions(1, `{"label":"test"}`))
+	require.NoError(t, err)
+	require.Len(t, instances, 1)
+
+	instances, err = client.ListInstances(ctx, linodego.NewListOptions(1, ""))
+	require.NoError(t, err)
+	require.Len(t, instances, 2)
+
+	require.NoError(t, rec.Stop())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	fixture := string(data)
+	require.True(t, strings.HasPrefix(fixture, "---\nversion: 1\n"))
+	require.NotContains(t, fixture, "super-secret-token")
+	require.NotContains(t, fixture, "hunter2")
+	require.NotContains(t, fixture, "session=abc")
+	require.Contains(t, fixture, SanitizedValue)
+
+	// Replay the interactions without the server
+	server.Close()
+
+	rec, err = New(path, Options{Mode: ModeReplaying})
+	require.NoError(t, err)
+
+	client = newClient(t, rec, server.URL)
+
+	// The X-Filter header is used to select the matching interaction
+	instances, err = client.ListInstances(ctx, linodego.NewListOptions(1, ""))
+	require.NoError(t, err)
+	require.Len(t, instances, 2)
+
+	instances, err = client.ListInstances(ctx, linodego.NewListOptions(1, `{"label": "test"}`))
+	require.NoError(t, err)
+	require.Len(t, instances, 1)
+
+	instance, err = client.CreateInstance(ctx, linodego.InstanceCreateOptions{Region: "us-east"})
+	require.NoError(t, err)
+	require.Equal(t, "test", instance.Label)
+
+	// Each interaction is only replayed once
+	_, err = client.CreateInstance(ctx, linodego.InstanceCreateOptions{Region: "us-east"})
+
+	var unmatched *UnmatchedRequestError
+
+	require.True(t, errors.As(err, &unmatched))
+	require.Equal(t, http.MethodPost, unmatched.Method)
+	require.ErrorContains(t, err, "no unused interaction")
+}
+
+func TestRecorder_MatchBody(t *testing.T) {
+	cassette := &Cassette{
+		Interactions: []*Interaction{
+			{
+				Request:  Request{Method: http.MethodPost, URL: "https://api.linode.com/v4/volumes", Body: `{"label":"a"}`},
+				Response: Response{Code: http.StatusOK, Body: `{"id":1,"label":"a"}`},
+			},
+			{
+				Request:  Request{Method: http.MethodPost, URL: "https://api.linode.com/v4/volumes", Body: `{"label":"b"}`},
+				Response: Response{Code: http.StatusOK, Body: `{"id":2,"label":"b"}`},
+			},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+	require.NoError(t, cassette.Save(path))
+
+	rec, err := New(path, Options{
+		Mode:     ModeReplaying,
+		Matchers: append([]Matcher{MatchBody}, DefaultMatchers...),
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.linode.com/v4/volumes", strings.NewReader(`{"label": "b"}`))
+	require.NoError(t, err)
+
+	resp, err := rec.RoundTrip(req)
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":2,"label":"b"}`, string(body))
+	require.Equal(t, "200 OK", resp.Status)
+}
+
+func TestRecorder_ReplayIntegrationFixture(t *testing.T) {
+	rec, err := New("../../test/integration/fixtures/ExampleCreateNodeBalancer.yaml", Options{Mode: ModeReplaying})
+	require.NoError(t, err)
+
+	client := newClient(t, rec, "https://api.linode.com")
+	client.SetAPIVersion("v4beta")
+
+	nodebalancer, err := client.CreateNodeBalancer(context.Background(), linodego.NodeBalancerCreateOptions{
+		Region: "us-southeast",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "us-southeast", nodebalancer.Region)
+}
@@ -0,0 +1,103 @@
+package recorder
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// Scrubber modifies an interaction before it is saved to a cassette.
+type Scrubber func(i *Interaction)
+
+// SanitizedValue replaces secrets removed by scrubbers.
+const SanitizedValue = "[SANITIZED]"
+
+// DefaultScrubbers remove credentials, secrets and volatile headers from recorded interactions.
+var DefaultScrubbers = []Scrubber{
+	ScrubRequestHeaders("Authorization", "Cookie"),
+	ScrubResponseHeaders(
+		"Set-Cookie",
+		"Date",
+		"Retry-After",
+		"X-Customer-Uuid",
+		"X-Ratelimit-Reset",
+		"X-Ratelimit-Remaining",
+		"X-Spec-Version",
+	),
+	ScrubJSONFields(
+		"root_pass",
+		"password",
+		"token",
+		"secret",
+		"access_key",
+		"secret_key",
+		"access_key_id",
+		"access_key_secret",
+		"basic_authentication_password",
+		"kubeconfig",
+	),
+	ScrubQueryParams("AWSAccessKeyId", "Signature"),
+}
+
+// ScrubRequestHeaders removes the given headers from recorded requests.
+func ScrubRequestHeaders(names ...string) Scrubber {
+	return func(i *Interaction) {
+		for _, name := range names {
+			i.Request.Headers.Del(name)
+		}
+	}
+}
+
+// ScrubResponseHeaders removes the given headers from recorded responses.
+func ScrubResponseHeaders(names ...string) Scrubber {
+	return func(i *Interaction) {
+		for _, name := range names {
+			i.Response.Headers.Del(name)
+		}
+	}
+}
+
+// ScrubJSONFields replaces the string values of the given JSON fields in recorded
+// request and response bodies with SanitizedValue.
+func ScrubJSONFields(fields ...string) Scrubber {
+	patterns := make([]*regexp.Regexp, len(fields))
+	for i, field := range fields {
+		patterns[i] = regexp.MustCompile(fmt.Sprintf(`("%s"\s*:\s*)"(?:[^"\\]|\\.)*"`, regexp.QuoteMeta(field)))
+	}
+
+	replacement := `${1}"` + SanitizedValue + `"`
+
+	return func(i *Interaction) {
+		for _, pattern := range patterns {
+			i.Request.Body = pattern.ReplaceAllString(i.Request.Body, replacement)
+			i.Response.Body = pattern.ReplaceAllString(i.Response.Body, replacement)
+		}
+	}
+}
+
+// ScrubQueryParams replaces the values of the given query parameters in recorded
+// request URLs with SanitizedValue.
+func ScrubQueryParams(names ...string) Scrubber {
+	return func(i *Interaction) {
+		u, err := url.Parse(i.Request.URL)
+		if err != nil {
+			return
+		}
+
+		query := u.Query()
+		changed := false
+
+		for _, name := range names {
+			if query.Has(name) {
+				query.Set(name, SanitizedValue)
+
+				changed = true
+			}
+		}
+
+		if changed {
+			u.RawQuery = query.Encode()
+			i.Request.URL = u.String()
+		}
+	}
+}
@@ -0,0 +1,746 @@
+package linodegotest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/linode/linodego/v2"
+)
+
+// resource describes a collection of objects served by the Server.
+type resource struct {
+	// path is the collection path, with wildcards for parent IDs,
+	// e.g. "linode/instances/{linode}/disks".
+	path string
+
+	// create initializes a new object from the request body.
+	// If nil, objects cannot be created through the API.
+	create func(s *Server, parent, obj, body object) error
+
+	// update is invoked after the request body is merged into an object.
+	update func(s *Server, obj object)
+
+	// delete is invoked after an object is removed.
+	// If nil, objects cannot be deleted through the API.
+	delete func(s *Server, parent, obj object)
+
+	// render optionally decorates an object before it is returned.
+	render func(s *Server, path string, obj object) object
+
+	// descending orders list results by descending ID by default.
+	descending bool
+}
+
+// Domain Event actions are not defined by linodego.
+const (
+	actionDomainCreate linodego.EventAction = "domain_create"
+	actionDomainDelete linodego.EventAction = "domain_delete"
+)
+
+// protectedFields are fields that cannot be modified by update requests.
+var protectedFields = []string{"id", "created", "updated", "status"}
+
+func (s *Server) registerRoutes(mux *http.ServeMux) {
+	for _, res := range s.resources() {
+		s.registerResource(mux, res)
+	}
+
+	s.handle(mux, http.MethodPost, "linode/instances/{id}/boot",
+		s.instanceAction(linodego.ActionLinodeBoot, linodego.InstanceBooting, linodego.InstanceRunning))
+	s.handle(mux, http.MethodPost, "linode/instances/{id}/reboot",
+		s.instanceAction(linodego.ActionLinodeReboot, linodego.InstanceRebooting, linodego.InstanceRunning))
+	s.handle(mux, http.MethodPost, "linode/instances/{id}/shutdown",
+		s.instanceAction(linodego.ActionLinodeShutdown, linodego.InstanceShuttingDown, linodego.InstanceOffline))
+
+	s.handle(mux, http.MethodPost, "volumes/{id}/attach", s.attachVolume)
+	s.handle(mux, http.MethodPost, "volumes/{id}/detach", s.detachVolume)
+
+	s.handle(mux, http.MethodGet, "networking/firewalls/{id}/rules", s.getFirewallRules)
+	s.handle(mux, http.MethodPut, "networking/firewalls/{id}/rules", s.updateFirewallRules)
+
+	s.handle(mux, http.MethodPost, "account/events/{id}/seen", s.markEventsSeen)
+}
+
+func (s *Server) resources() []*resource {
+	return []*resource{
+		{
+			path:   "linode/instances",
+			create: createInstance,
+			update: touch,
+			delete: func(s *Server, _, obj object) {
+				s.finishedEvent(linodego.ActionLinodeDelete, entityOf(obj, linodego.EntityLinode, "linode/instances"), nil)
+			},
+		},
+		{
+			path:   "linode/instances/{linode}/disks",
+			create: createDisk,
+			update: touch,
+			delete: func(s *Server, parent, obj object) {
+				s.finishedEvent(linodego.ActionDiskDelete,
+					entityOf(parent, linodego.EntityLinode, "linode/instances"),
+					entityOf(obj, linodego.EntityDisk, fmt.Sprintf("linode/instances/%v/disks", parent["id"])),
+				)
+			},
+		},
+		{
+			path:   "linode/instances/{linode}/configs",
+			create: createGeneric,
+			update: touch,
+			delete: func(*Server, object, object) {},
+		},
+		{
+			path:   "volumes",
+			create: createVolume,
+			update: touch,
+			delete: func(s *Server, _, obj object) {
+				s.finishedEvent(linodego.ActionVolumeDelete, entityOf(obj, linodego.EntityVolume, "volumes"), nil)
+			},
+		},
+		{
+			path:   "networking/firewalls",
+			create: createFirewall,
+			update: touch,
+			delete: func(s *Server, _, obj object) {
+				s.finishedEvent(linodego.ActionFirewallDelete,
+					entityOf(obj, linodego.EntityFirewall, "networking/firewalls"), nil)
+			},
+		},
+		{
+			path:   "domains",
+			create: createDomain,
+			update: touch,
+			delete: func(s *Server, _, obj object) {
+				s.finishedEvent(actionDomainDelete, entityOf(obj, linodego.EntityDomain, "domains"), nil)
+			},
+		},
+		{
+			path:   "domains/{domain}/records",
+			create: createGeneric,
+			update: touch,
+			delete: func(*Server, object, object) {},
+		},
+		{
+			path:   "nodebalancers",
+			create: createNodeBalancer,
+			update: touch,
+			delete: func(s *Server, _, obj object) {
+				s.finishedEvent(linodego.ActionNodebalancerDelete,
+					entityOf(obj, linodego.EntityNodebalancer, "nodebalancers"), nil)
+			},
+		},
+		{
+			path:   "nodebalancers/{nodebalancer}/configs",
+			create: createNodeBalancerConfig,
+			update: touch,
+			delete: func(*Server, object, object) {},
+		},
+		{
+			path:   "vpcs",
+			create: createVPC,
+			update: touch,
+			delete: func(s *Server, _, obj object) {
+				s.finishedEvent(linodego.ActionVPCDelete, entityOf(obj, linodego.EntityVPC, "vpcs"), nil)
+			},
+			render: renderVPC,
+		},
+		{
+			path:   "vpcs/{vpc}/subnets",
+			create: createSubnet,
+			update: touch,
+			delete: func(*Server, object, object) {},
+		},
+		{
+			path:       "account/events",
+			descending: true,
+		},
+	}
+}
+
+func (s *Server) registerResource(mux *http.ServeMux, res *resource) {
+	item := res.path + "/{id}"
+
+	s.handle(mux, http.MethodGet, res.path, func(r *http.Request, _ object) (any, error) {
+		path, _, err := s.resolveCollection(r, res)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.listObjects(r, res, path)
+	})
+
+	s.handle(mux, http.MethodGet, item, func(r *http.Request, _ object) (any, error) {
+		path, obj, err := s.resolveObject(r, res)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.render(res, path, obj), nil
+	})
+
+	if res.create != nil {
+		s.handle(mux, http.MethodPost, res.path, func(r *http.Request, body object) (any, error) {
+			path, parent, err := s.resolveCollection(r, res)
+			if err != nil {
+				return nil, err
+			}
+
+			now := s.now()
+			obj := object{
+				"id":      s.allocateID(),
+				"created": now,
+				"updated": now,
+			}
+
+			if err := res.create(s, parent, obj, body); err != nil {
+				return nil, err
+			}
+
+			s.collections[path] = append(s.collections[path], obj)
+
+			return s.render(res, path, obj), nil
+		})
+	}
+
+	if res.update != nil {
+		s.handle(mux, http.MethodPut, item, func(r *http.Request, body object) (any, error) {
+			path, obj, err := s.resolveObject(r, res)
+			if err != nil {
+				return nil, err
+			}
+
+			for key, value := range body {
+				if !slices.Contains(protectedFields, key) {
+					obj[key] = value
+				}
+			}
+
+			res.update(s, obj)
+
+			return s.render(res, path, obj), nil
+		})
+	}
+
+	if res.delete != nil {
+		s.handle(mux, http.MethodDelete, item, func(r *http.Request, _ object) (any, error) {
+			path, parent, err := s.resolveCollection(r, res)
+			if err != nil {
+				return nil, err
+			}
+
+			id, err := pathID(r, "id")
+			if err != nil {
+				return nil, err
+			}
+
+			obj := s.remove(path, id)
+			if obj == nil {
+				return nil, errNotFound()
+			}
+
+			s.removeChildren(fmt.Sprintf("%s/%d", path, id))
+			res.delete(s, parent, obj)
+
+			return object{}, nil
+		})
+	}
+}
+
+// resolveCollection returns the resolved path of the collection targeted by the request,
+// along with its parent object if the collection is nested.
+func (s *Server) resolveCollection(r *http.Request, res *resource) (string, object, error) {
+	segments := strings.Split(res.path, "/")
+
+	var parent object
+
+	for i, segment := range segments {
+		name, ok := strings.CutPrefix(segment, "{")
+		if !ok {
+			continue
+		}
+
+		id, err := pathID(r, strings.TrimSuffix(name, "}"))
+		if err != nil {
+			return "", nil, err
+		}
+
+		parent = s.find(strings.Join(segments[:i], "/"), id)
+		if parent == nil {
+			return "", nil, errNotFound()
+		}
+
+		segments[i] = strconv.Itoa(id)
+	}
+
+	return strings.Join(segments, "/"), parent, nil
+}
+
+func (s *Server) resolveObject(r *http.Request, res *resource) (string, object, error) {
+	path, _, err := s.resolveCollection(r, res)
+	if err != nil {
+		return "", nil, err
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		return "", nil, err
+	}
+
+	obj := s.find(path, id)
+	if obj == nil {
+		return "", nil, errNotFound()
+	}
+
+	return path, obj, nil
+}
+
+func (s *Server) listObjects(r *http.Request, res *resource, path string) (any, error) {
+	objects, err := filterObjects(s.collections[path], r.Header.Get("X-Filter"), res.descending)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := 1, DefaultPageSize
+
+	if v := r.URL.Query().Get("page"); v != "" {
+		if page, err = strconv.Atoi(v); err != nil || page < 1 {
+			return nil, errBadRequest("page", "invalid page: %s", v)
+		}
+	}
+
+	if v := r.URL.Query().Get("page_size"); v != "" {
+		if pageSize, err = strconv.Atoi(v); err != nil || pageSize < 1 {
+			return nil, errBadRequest("page_size", "invalid page size: %s", v)
+		}
+	}
+
+	pages := max((len(objects)+pageSize-1)/pageSize, 1)
+	start := min((page-1)*pageSize, len(objects))
+	end := min(start+pageSize, len(objects))
+
+	data := make([]object, 0, end-start)
+	for _, obj := range objects[start:end] {
+		data = append(data, s.render(res, path, obj))
+	}
+
+	return object{
+		"data":    data,
+		"page":    page,
+		"pages":   pages,
+		"results": len(objects),
+	}, nil
+}
+
+func (s *Server) render(res *resource, path string, obj object) object {
+	if res.render == nil {
+		return obj
+	}
+
+	return res.render(s, fmt.Sprintf("%s/%v", path, obj["id"]), obj)
+}
+
+func (s *Server) instanceAction(
+	action linodego.EventAction,
+	status, final linodego.InstanceStatus,
+) handlerFunc {
+	return func(r *http.Request, _ object) (any, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return nil, err
+		}
+
+		instance := s.find("linode/instances", id)
+		if instance == nil {
+			return nil, errNotFound()
+		}
+
+		instance["status"] = status
+
+		s.startEvent(action, entityOf(instance, linodego.EntityLinode, "linode/instances"), nil, func() {
+			instance["status"] = final
+		})
+
+		return object{}, nil
+	}
+}
+
+func (s *Server) attachVolume(r *http.Request, body object) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	volume := s.find("volumes", id)
+	if volume == nil {
+		return nil, errNotFound()
+	}
+
+	linodeID, _ := toInt(body["linode_id"])
+
+	instance := s.find("linode/instances", linodeID)
+	if instance == nil {
+		return nil, errBadRequest("linode_id", "Linode %d not found", linodeID)
+	}
+
+	s.startEvent(linodego.ActionVolumeAttach, entityOf(volume, linodego.EntityVolume, "volumes"), nil, func() {
+		volume["linode_id"] = linodeID
+		volume["linode_label"] = instance["label"]
+	})
+
+	return volume, nil
+}
+
+func (s *Server) detachVolume(r *http.Request, _ object) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	volume := s.find("volumes", id)
+	if volume == nil {
+		return nil, errNotFound()
+	}
+
+	s.startEvent(linodego.ActionVolumeDetach, entityOf(volume, linodego.EntityVolume, "volumes"), nil, func() {
+		volume["linode_id"] = nil
+		volume["linode_label"] = nil
+	})
+
+	return object{}, nil
+}
+
+func (s *Server) getFirewallRules(r *http.Request, _ object) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	firewall := s.find("networking/firewalls", id)
+	if firewall == nil {
+		return nil, errNotFound()
+	}
+
+	return firewall["rules"], nil
+}
+
+func (s *Server) updateFirewallRules(r *http.Request, body object) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	firewall := s.find("networking/firewalls", id)
+	if firewall == nil {
+		return nil, errNotFound()
+	}
+
+	firewall["rules"] = body
+	touch(s, firewall)
+
+	return body, nil
+}
+
+func (s *Server) markEventsSeen(r *http.Request, _ object) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	if s.find("account/events", id) == nil {
+		return nil, errNotFound()
+	}
+
+	// Marking an Event as seen also marks all older Events as seen
+	for _, event := range s.collections["account/events"] {
+		if eventID, _ := toInt(event["id"]); eventID <= id {
+			event["seen"] = true
+		}
+	}
+
+	return object{}, nil
+}
+
+// startEvent records a started Event that finishes, running complete,
+// once the server's transition delay has elapsed.
+func (s *Server) startEvent(action linodego.EventAction, entity, secondary object, complete func()) {
+	event := s.addEvent(action, entity, secondary, linodego.EventStarted)
+
+	s.schedule(func() {
+		if complete != nil {
+			complete()
+		}
+
+		event["status"] = linodego.EventFinished
+		event["percent_complete"] = 100
+	})
+}
+
+// finishedEvent records an Event that has already finished.
+func (s *Server) finishedEvent(action linodego.EventAction, entity, secondary object) {
+	event := s.addEvent(action, entity, secondary, linodego.EventFinished)
+	event["percent_complete"] = 100
+}
+
+func (s *Server) addEvent(action linodego.EventAction, entity, secondary object, status linodego.EventStatus) object {
+	event := object{
+		"id":               s.allocateID(),
+		"action":           action,
+		"created":          s.now(),
+		"status":           status,
+		"percent_complete": 0,
+		"entity":           entity,
+		"secondary_entity": secondary,
+		"seen":             false,
+		"read":             false,
+		"username":         "linodegotest",
+	}
+
+	s.collections["account/events"] = append(s.collections["account/events"], event)
+
+	return event
+}
+
+func entityOf(obj object, entityType linodego.EntityType, path string) object {
+	label := obj["label"]
+	if label == nil {
+		label = obj["domain"]
+	}
+
+	return object{
+		"id":    obj["id"],
+		"label": label,
+		"type":  entityType,
+		"url":   fmt.Sprintf("/v4/%s/%v", path, obj["id"]),
+	}
+}
+
+func createInstance(s *Server, _, obj, body object) error {
+	if body["region"] == nil {
+		return errBadRequest("region", "region is required")
+	}
+
+	copyFields(obj, body, "label", "region", "type", "image", "group", "tags")
+	setDefault(obj, "label", fmt.Sprintf("linode%v", obj["id"]))
+	setDefault(obj, "tags", []any{})
+	setDefault(obj, "group", "")
+
+	id, _ := toInt(obj["id"])
+
+	obj["ipv4"] = []any{fakeIPv4(id)}
+	obj["hypervisor"] = "kvm"
+	obj["status"] = linodego.InstanceProvisioning
+
+	final := linodego.InstanceOffline
+	if booted, ok := body["booted"].(bool); ok && booted || !ok && body["image"] != nil {
+		final = linodego.InstanceRunning
+	}
+
+	s.startEvent(linodego.ActionLinodeCreate, entityOf(obj, linodego.EntityLinode, "linode/instances"), nil, func() {
+		obj["status"] = final
+	})
+
+	return nil
+}
+
+func createDisk(s *Server, parent, obj, body object) error {
+	copyFields(obj, body, "label", "size", "filesystem")
+	setDefault(obj, "label", fmt.Sprintf("disk%v", obj["id"]))
+	setDefault(obj, "filesystem", "ext4")
+
+	obj["linode_id"] = parent["id"]
+	obj["status"] = linodego.DiskNotReady
+
+	s.startEvent(linodego.ActionDiskCreate,
+		entityOf(parent, linodego.EntityLinode, "linode/instances"),
+		entityOf(obj, linodego.EntityDisk, fmt.Sprintf("linode/instances/%v/disks", parent["id"])),
+		func() {
+			obj["status"] = linodego.DiskReady
+		},
+	)
+
+	return nil
+}
+
+func createVolume(s *Server, _, obj, body object) error {
+	if body["region"] == nil && body["linode_id"] == nil {
+		return errBadRequest("region", "region is required")
+	}
+
+	copyFields(obj, body, "label", "region", "size", "tags", "linode_id")
+	setDefault(obj, "label", fmt.Sprintf("volume%v", obj["id"]))
+	setDefault(obj, "size", 20)
+	setDefault(obj, "tags", []any{})
+	setDefault(obj, "linode_id", nil)
+
+	obj["filesystem_path"] = fmt.Sprintf("/dev/disk/by-id/scsi-0Linode_Volume_%v", obj["label"])
+	obj["status"] = linodego.VolumeCreating
+
+	s.startEvent(linodego.ActionVolumeCreate, entityOf(obj, linodego.EntityVolume, "volumes"), nil, func() {
+		obj["status"] = linodego.VolumeActive
+	})
+
+	return nil
+}
+
+func createFirewall(s *Server, _, obj, body object) error {
+	copyFields(obj, body, "label", "rules", "tags")
+	setDefault(obj, "label", fmt.Sprintf("firewall%v", obj["id"]))
+	setDefault(obj, "tags", []any{})
+	setDefault(obj, "rules", object{
+		"inbound":         []any{},
+		"inbound_policy":  "ACCEPT",
+		"outbound":        []any{},
+		"outbound_policy": "ACCEPT",
+	})
+
+	obj["status"] = linodego.FirewallEnabled
+
+	s.finishedEvent(linodego.ActionFirewallCreate, entityOf(obj, linodego.EntityFirewall, "networking/firewalls"), nil)
+
+	return nil
+}
+
+func createDomain(s *Server, _, obj, body object) error {
+	if body["domain"] == nil {
+		return errBadRequest("domain", "domain is required")
+	}
+
+	copyFields(obj, body, "domain", "type", "soa_email", "description", "tags")
+	setDefault(obj, "type", linodego.DomainTypeMaster)
+	setDefault(obj, "tags", []any{})
+
+	obj["status"] = linodego.DomainStatusActive
+
+	s.finishedEvent(actionDomainCreate, entityOf(obj, linodego.EntityDomain, "domains"), nil)
+
+	return nil
+}
+
+func createNodeBalancer(s *Server, _, obj, body object) error {
+	if body["region"] == nil {
+		return errBadRequest("region", "region is required")
+	}
+
+	copyFields(obj, body, "label", "region", "client_conn_throttle", "tags")
+	setDefault(obj, "label", fmt.Sprintf("nodebalancer%v", obj["id"]))
+	setDefault(obj, "client_conn_throttle", 0)
+	setDefault(obj, "tags", []any{})
+
+	id, _ := toInt(obj["id"])
+
+	obj["hostname"] = fmt.Sprintf("nb-%s.%v.linodeusercontent.com", strings.ReplaceAll(fakeIPv4(id), ".", "-"), obj["region"])
+	obj["ipv4"] = fakeIPv4(id)
+
+	s.finishedEvent(linodego.ActionNodebalancerCreate,
+		entityOf(obj, linodego.EntityNodebalancer, "nodebalancers"), nil)
+
+	return nil
+}
+
+func createNodeBalancerConfig(s *Server, parent, obj, body object) error {
+	if err := createGeneric(s, parent, obj, body); err != nil {
+		return err
+	}
+
+	obj["nodebalancer_id"] = parent["id"]
+
+	setDefault(obj, "port", 80)
+	setDefault(obj, "protocol", linodego.ProtocolHTTP)
+	setDefault(obj, "algorithm", linodego.AlgorithmRoundRobin)
+
+	return nil
+}
+
+func createVPC(s *Server, _, obj, body object) error {
+	if body["region"] == nil {
+		return errBadRequest("region", "region is required")
+	}
+
+	copyFields(obj, body, "label", "region", "description")
+	setDefault(obj, "label", fmt.Sprintf("vpc%v", obj["id"]))
+	setDefault(obj, "description", "")
+
+	subnets, _ := body["subnets"].([]any)
+	path := fmt.Sprintf("vpcs/%v/subnets", obj["id"])
+
+	for _, v := range subnets {
+		subnetBody, ok := v.(map[string]any)
+		if !ok {
+			return errBadRequest("subnets", "invalid subnet")
+		}
+
+		now := s.now()
+		subnet := object{"id": s.allocateID(), "created": now, "updated": now}
+
+		if err := createSubnet(s, obj, subnet, subnetBody); err != nil {
+			return err
+		}
+
+		s.collections[path] = append(s.collections[path], subnet)
+	}
+
+	s.finishedEvent(linodego.ActionVPCCreate, entityOf(obj, linodego.EntityVPC, "vpcs"), nil)
+
+	return nil
+}
+
+func renderVPC(s *Server, path string, obj object) object {
+	result := make(object, len(obj)+1)
+	for key, value := range obj {
+		result[key] = value
+	}
+
+	subnets := s.collections[path+"/subnets"]
+	if subnets == nil {
+		subnets = []object{}
+	}
+
+	result["subnets"] = subnets
+
+	return result
+}
+
+func createSubnet(_ *Server, _, obj, body object) error {
+	copyFields(obj, body, "label", "ipv4")
+	setDefault(obj, "label", fmt.Sprintf("subnet%v", obj["id"]))
+
+	obj["linodes"] = []any{}
+
+	return nil
+}
+
+// createGeneric creates an object from all fields of the request body.
+func createGeneric(_ *Server, _, obj, body object) error {
+	for key, value := range body {
+		if !slices.Contains(protectedFields, key) {
+			obj[key] = value
+		}
+	}
+
+	return nil
+}
+
+func touch(s *Server, obj object) {
+	obj["updated"] = s.now()
+}
+
+func copyFields(dst, src object, keys ...string) {
+	for _, key := range keys {
+		if value, ok := src[key]; ok {
+			dst[key] = value
+		}
+	}
+}
+
+func setDefault(obj object, key string, value any) {
+	if v, ok := obj[key]; !ok || v == nil || v == "" {
+		obj[key] = value
+	}
+}
+
+// fakeIPv4 returns an address in the TEST-NET-2 documentation range for the given ID.
+func fakeIPv4(id int) string {
+	return fmt.Sprintf("198.51.%d.%d", (id/254)%256, id%254+1)
+}
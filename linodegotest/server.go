@@ -0,0 +1,476 @@
+// Package linodegotest provides a stateful, in-memory fake of the Linode API
+// for use in unit tests of code built on linodego.
+//
+// The fake is served by an httptest.Server and supports a subset of the API,
+// including Instances, Disks, Configs, Volumes, Firewalls, Domains, NodeBalancers,
+// VPCs and Events. Resources are assigned IDs on creation, transition through
+// their intermediate statuses (e.g. "provisioning" to "running"), and generate
+// Events that finish once the transition completes.
+//
+//	server := linodegotest.NewServer(t, linodegotest.ServerOptions{})
+//	client := server.Client()
+//
+//	instance, _ := client.CreateInstance(ctx, linodego.InstanceCreateOptions{Region: "us-east"})
+//	instance, _ = client.WaitForInstanceStatus(ctx, instance.ID, linodego.InstanceRunning)
+package linodegotest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego/v2"
+)
+
+const (
+	// DefaultPageSize is the page size used by list endpoints when none is requested.
+	DefaultPageSize = 100
+
+	// TestToken is the API token used by clients returned by Server.Client.
+	TestToken = "linodegotest"
+
+	timeFormat = "2006-01-02T15:04:05"
+)
+
+// ServerOptions configures a Server.
+type ServerOptions struct {
+	// TransitionDelay is the time it takes for resources in an intermediate status
+	// (e.g. "provisioning") to reach their final status and for the associated Events
+	// to finish. If 0, transitions complete before the next request is handled.
+	TransitionDelay time.Duration
+}
+
+// Server is an in-memory fake of the Linode API.
+// A Server is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, e.g. "http://127.0.0.1:1234".
+	URL string
+
+	t       testing.TB
+	options ServerOptions
+	server  *httptest.Server
+
+	mu          sync.Mutex
+	nextID      int
+	collections map[string][]object
+	transitions []transition
+	errors      []*InjectedError
+}
+
+// InjectedError describes an error returned by the Server for matching requests.
+type InjectedError struct {
+	// Method is the HTTP method to match. If empty, all methods match.
+	Method string
+
+	// Path is the endpoint to match, relative to the API version,
+	// e.g. "linode/instances/123". Numeric IDs may be replaced with "{id}"
+	// to match any ID, e.g. "linode/instances/{id}/boot".
+	Path string
+
+	// Status is the HTTP status code of the response. Defaults to 500.
+	Status int
+
+	// Reason is the error reason returned in the response body.
+	Reason string
+
+	// Field is the optional field the error applies to.
+	Field string
+
+	// Times is the number of matching requests to fail.
+	// If <= 0, all matching requests fail until ClearErrors is called.
+	Times int
+}
+
+type object = map[string]any
+
+type transition struct {
+	at    time.Time
+	apply func()
+}
+
+type apiError struct {
+	status int
+	reason string
+	field  string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("[%03d] %s", e.status, e.reason)
+}
+
+func errNotFound() error {
+	return &apiError{status: http.StatusNotFound, reason: "Not found"}
+}
+
+func errBadRequest(field, format string, args ...any) error {
+	return &apiError{status: http.StatusBadRequest, reason: fmt.Sprintf(format, args...), field: field}
+}
+
+// handlerFunc handles a request with a decoded JSON body, returning the response body.
+type handlerFunc func(r *http.Request, body object) (any, error)
+
+// NewServer starts a new Server that is closed when the test completes.
+func NewServer(t testing.TB, options ServerOptions) *Server {
+	t.Helper()
+
+	s := &Server{
+		t:           t,
+		options:     options,
+		nextID:      1000,
+		collections: make(map[string][]object),
+	}
+
+	mux := http.NewServeMux()
+	s.registerRoutes(mux)
+
+	s.server = httptest.NewServer(mux)
+	s.URL = s.server.URL
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a new linodego Client configured to use the server.
+// The client polls frequently and retries failed requests at most twice
+// so that tests using WaitFor* helpers or injected errors complete quickly.
+func (s *Server) Client() *linodego.Client {
+	s.t.Helper()
+
+	client, err := linodego.NewClient(s.server.Client())
+	if err != nil {
+		s.t.Fatalf("failed to create client: %s", err)
+	}
+
+	client.SetBaseURL(s.URL).
+		SetToken(TestToken).
+		SetPollDelay(10 * time.Millisecond).
+		SetRetryWaitTime(time.Millisecond).
+		SetRetryMaxWaitTime(10 * time.Millisecond).
+		SetRetryCount(2)
+
+	return &client
+}
+
+// InjectError causes matching requests to fail with the given error.
+func (s *Server) InjectError(e InjectedError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e.Status == 0 {
+		e.Status = http.StatusInternalServerError
+	}
+
+	if e.Reason == "" {
+		e.Reason = http.StatusText(e.Status)
+	}
+
+	e.Path = strings.Trim(e.Path, "/")
+
+	s.errors = append(s.errors, &e)
+}
+
+// ClearErrors removes all injected errors.
+func (s *Server) ClearErrors() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.errors = nil
+}
+
+// CompleteTransitions immediately completes all pending status transitions and Events.
+func (s *Server) CompleteTransitions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.advance(time.Time{})
+}
+
+// Seed adds an object to the collection at the given path, e.g. "linode/instances"
+// or "linode/instances/123/disks", without generating any Events.
+// The object is converted to JSON, so it may be a linodego struct such as
+// linodego.Instance. If the object has no ID, one is allocated.
+// The ID of the seeded object is returned.
+func (s *Server) Seed(path string, value any) (int, error) {
+	obj, err := toObject(value)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, _ := toInt(obj["id"])
+	if id == 0 {
+		id = s.allocateID()
+		obj["id"] = id
+	}
+
+	now := s.now()
+
+	for _, key := range []string{"created", "updated"} {
+		if v, ok := obj[key]; !ok || v == nil {
+			obj[key] = now
+		}
+	}
+
+	path = strings.Trim(path, "/")
+	s.collections[path] = append(s.collections[path], obj)
+
+	return id, nil
+}
+
+// Update merges the given fields into the object with the given ID in the collection
+// at the given path. This can be used to force a resource into a specific status.
+func (s *Server) Update(path string, id int, fields map[string]any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj := s.find(strings.Trim(path, "/"), id)
+	if obj == nil {
+		return fmt.Errorf("object %d not found in %s", id, path)
+	}
+
+	for key, value := range fields {
+		obj[key] = value
+	}
+
+	return nil
+}
+
+// Get decodes the object with the given ID in the collection at the given path into result.
+func (s *Server) Get(path string, id int, result any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj := s.find(strings.Trim(path, "/"), id)
+	if obj == nil {
+		return fmt.Errorf("object %d not found in %s", id, path)
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, result)
+}
+
+func (s *Server) handle(mux *http.ServeMux, method, path string, handler handlerFunc) {
+	mux.HandleFunc(method+" /{version}/"+path, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.advance(time.Now())
+
+		result, err := s.serve(r, handler)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		_ = json.NewEncoder(w).Encode(result)
+	})
+}
+
+func (s *Server) serve(r *http.Request, handler handlerFunc) (any, error) {
+	if r.Header.Get("Authorization") == "" {
+		return nil, &apiError{status: http.StatusUnauthorized, reason: "Invalid Token"}
+	}
+
+	if err := s.injectedError(r); err != nil {
+		return nil, err
+	}
+
+	var body object
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, errBadRequest("", "failed to read request body: %s", err)
+	}
+
+	if len(strings.TrimSpace(string(data))) > 0 {
+		if err := json.Unmarshal(data, &body); err != nil {
+			return nil, errBadRequest("", "invalid JSON: %s", err)
+		}
+	}
+
+	if body == nil {
+		body = make(object)
+	}
+
+	return handler(r, body)
+}
+
+func (s *Server) injectedError(r *http.Request) error {
+	endpoint := apiPath(r)
+	template := linodego.RequestEndpointTemplate(endpoint)
+
+	for i, e := range s.errors {
+		if e.Method != "" && !strings.EqualFold(e.Method, r.Method) {
+			continue
+		}
+
+		if e.Path != endpoint && e.Path != template {
+			continue
+		}
+
+		if e.Times > 0 {
+			e.Times--
+			if e.Times == 0 {
+				s.errors = slices.Delete(s.errors, i, i+1)
+			}
+		}
+
+		return &apiError{status: e.Status, reason: e.Reason, field: e.Field}
+	}
+
+	return nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		apiErr = &apiError{status: http.StatusInternalServerError, reason: err.Error()}
+	}
+
+	reason := map[string]string{"reason": apiErr.reason}
+	if apiErr.field != "" {
+		reason["field"] = apiErr.field
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(apiErr.status)
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]string{reason},
+	})
+}
+
+// apiPath returns the path of the request relative to the API version.
+func apiPath(r *http.Request) string {
+	path := strings.TrimPrefix(r.URL.Path, "/"+r.PathValue("version"))
+	return strings.Trim(path, "/")
+}
+
+// advance applies all transitions due at the given time,
+// or all pending transitions if now is zero.
+func (s *Server) advance(now time.Time) {
+	pending := s.transitions[:0]
+
+	for _, t := range s.transitions {
+		if now.IsZero() || !t.at.After(now) {
+			t.apply()
+			continue
+		}
+
+		pending = append(pending, t)
+	}
+
+	s.transitions = pending
+}
+
+// schedule runs apply once the server's transition delay has elapsed.
+func (s *Server) schedule(apply func()) {
+	s.transitions = append(s.transitions, transition{
+		at:    time.Now().Add(s.options.TransitionDelay),
+		apply: apply,
+	})
+}
+
+func (s *Server) allocateID() int {
+	s.nextID++
+	return s.nextID
+}
+
+func (s *Server) now() string {
+	return time.Now().UTC().Format(timeFormat)
+}
+
+func (s *Server) find(path string, id int) object {
+	for _, obj := range s.collections[path] {
+		if objID, _ := toInt(obj["id"]); objID == id {
+			return obj
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) remove(path string, id int) object {
+	items := s.collections[path]
+
+	for i, obj := range items {
+		if objID, _ := toInt(obj["id"]); objID == id {
+			s.collections[path] = slices.Delete(items, i, i+1)
+			return obj
+		}
+	}
+
+	return nil
+}
+
+// removeChildren removes all collections nested under the given object path.
+func (s *Server) removeChildren(path string) {
+	for key := range s.collections {
+		if strings.HasPrefix(key, path+"/") {
+			delete(s.collections, key)
+		}
+	}
+}
+
+func toObject(value any) (object, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var result object
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func toInt(value any) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
+	case string:
+		i, err := strconv.Atoi(v)
+		return i, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func pathID(r *http.Request, name string) (int, error) {
+	id, err := strconv.Atoi(r.PathValue(name))
+	if err != nil {
+		return 0, errNotFound()
+	}
+
+	return id, nil
+}
@@ -0,0 +1,219 @@
+package linodegotest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_InstanceLifecycle(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	server := NewServer(t, ServerOptions{TransitionDelay: 50 * time.Millisecond})
+	client := server.Client()
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+		Image:  "linode/debian12",
+		Label:  "test-instance",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test-instance", instance.Label)
+	require.Equal(t, linodego.InstanceProvisioning, instance.Status)
+	require.NotEmpty(t, instance.IPv4)
+
+	instance, err = client.WaitForInstanceStatus(ctx, instance.ID, linodego.InstanceRunning)
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceRunning, instance.Status)
+
+	poller, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeShutdown)
+	require.NoError(t, err)
+
+	require.NoError(t, client.ShutdownInstance(ctx, instance.ID))
+
+	event, err := poller.WaitForFinished(ctx)
+	require.NoError(t, err)
+	require.Equal(t, linodego.EventFinished, event.Status)
+
+	instance, err = client.GetInstance(ctx, instance.ID)
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceOffline, instance.Status)
+
+	disk, err := client.CreateInstanceDisk(ctx, instance.ID, linodego.InstanceDiskCreateOptions{Label: "boot", Size: 1024})
+	require.NoError(t, err)
+	require.Equal(t, linodego.DiskNotReady, disk.Status)
+
+	disk, err = client.WaitForInstanceDiskStatus(ctx, instance.ID, disk.ID, linodego.DiskReady)
+	require.NoError(t, err)
+	require.Equal(t, 1024, disk.Size)
+
+	require.NoError(t, client.DeleteInstance(ctx, instance.ID))
+
+	_, err = client.GetInstance(ctx, instance.ID)
+	require.True(t, linodego.ErrHasStatus(err, http.StatusNotFound))
+
+	_, err = client.ListInstanceDisks(ctx, instance.ID, nil)
+	require.True(t, linodego.ErrHasStatus(err, http.StatusNotFound))
+}
+
+func TestServer_PaginationAndFilter(t *testing.T) {
+	ctx := context.Background()
+
+	server := NewServer(t, ServerOptions{})
+	client := server.Client()
+
+	for i := range 30 {
+		_, err := server.Seed("volumes", linodego.Volume{
+			Label:  fmt.Sprintf("volume-%02d", i),
+			Region: []string{"us-east", "us-west"}[i%2],
+			Size:   10 + i,
+			Tags:   []string{"test"},
+		})
+		require.NoError(t, err)
+	}
+
+	volumes, err := client.ListVolumes(ctx, &linodego.ListOptions{PageSize: 7})
+	require.NoError(t, err)
+	require.Len(t, volumes, 30)
+	require.Equal(t, "volume-00", volumes[0].Label)
+
+	page, err := client.ListVolumes(ctx, &linodego.ListOptions{PageOptions: &linodego.PageOptions{Page: 5}, PageSize: 7})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+
+	filter := linodego.And(linodego.Descending, "size",
+		linodego.FieldEq("region", "us-east"),
+		linodego.FieldGte("size", 30),
+		linodego.FieldEq("tags", "test"),
+	)
+
+	filterJSON, err := filter.MarshalJSON()
+	require.NoError(t, err)
+
+	volumes, err = client.ListVolumes(ctx, &linodego.ListOptions{Filter: string(filterJSON)})
+	require.NoError(t, err)
+	require.Len(t, volumes, 5)
+	require.Equal(t, 38, volumes[0].Size)
+
+	for _, volume := range volumes {
+		require.Equal(t, "us-east", volume.Region)
+	}
+
+	_, err = client.ListVolumes(ctx, &linodego.ListOptions{Filter: `{"label":{"+like":"x"}}`})
+	require.True(t, linodego.ErrHasStatus(err, http.StatusBadRequest))
+}
+
+func TestServer_InjectError(t *testing.T) {
+	ctx := context.Background()
+
+	server := NewServer(t, ServerOptions{})
+	client := server.Client()
+
+	server.InjectError(InjectedError{
+		Method: http.MethodPost,
+		Path:   "volumes/{id}/attach",
+		Status: http.StatusBadRequest,
+		Reason: "Volume is busy",
+		Times:  1,
+	})
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{Region: "us-east"})
+	require.NoError(t, err)
+
+	volume, err := client.CreateVolume(ctx, linodego.VolumeCreateOptions{Region: "us-east", Label: "data"})
+	require.NoError(t, err)
+
+	_, err = client.AttachVolume(ctx, volume.ID, &linodego.VolumeAttachOptions{LinodeID: instance.ID})
+	require.True(t, linodego.ErrHasStatus(err, http.StatusBadRequest))
+	require.ErrorContains(t, err, "Volume is busy")
+
+	_, err = client.AttachVolume(ctx, volume.ID, &linodego.VolumeAttachOptions{LinodeID: instance.ID})
+	require.NoError(t, err)
+
+	volume, err = client.WaitForVolumeLinodeID(ctx, volume.ID, &instance.ID)
+	require.NoError(t, err)
+	require.Equal(t, instance.ID, *volume.LinodeID)
+
+	// Rate limited requests are retried by the client
+	server.InjectError(InjectedError{Path: "linode/instances/{id}", Status: http.StatusTooManyRequests, Times: 1})
+
+	_, err = client.GetInstance(ctx, instance.ID)
+	require.NoError(t, err)
+
+	server.InjectError(InjectedError{Path: "linode/instances", Status: http.StatusServiceUnavailable})
+
+	_, err = client.ListInstances(ctx, nil)
+	require.True(t, linodego.ErrHasStatus(err, http.StatusServiceUnavailable))
+
+	server.ClearErrors()
+
+	instances, err := client.ListInstances(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, instances, 1)
+}
+
+func TestServer_NestedResources(t *testing.T) {
+	ctx := context.Background()
+
+	server := NewServer(t, ServerOptions{})
+	client := server.Client()
+
+	vpc, err := client.CreateVPC(ctx, linodego.VPCCreateOptions{
+		Label:  "vpc",
+		Region: "us-east",
+		Subnets: []linodego.VPCSubnetCreateOptions{
+			{Label: "subnet-a", IPv4: "10.0.0.0/24"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, vpc.Subnets, 1)
+
+	_, err = client.CreateVPCSubnet(ctx, linodego.VPCSubnetCreateOptions{Label: "subnet-b", IPv4: "10.0.1.0/24"}, vpc.ID)
+	require.NoError(t, err)
+
+	vpc, err = client.GetVPC(ctx, vpc.ID)
+	require.NoError(t, err)
+	require.Len(t, vpc.Subnets, 2)
+
+	domain, err := client.CreateDomain(ctx, linodego.DomainCreateOptions{Domain: "example.com", Type: linodego.DomainTypeMaster})
+	require.NoError(t, err)
+
+	record, err := client.CreateDomainRecord(ctx, domain.ID, linodego.DomainRecordCreateOptions{
+		Type:   linodego.RecordTypeA,
+		Name:   "www",
+		Target: "192.0.2.1",
+	})
+	require.NoError(t, err)
+
+	record, err = client.UpdateDomainRecord(ctx, domain.ID, record.ID, linodego.DomainRecordUpdateOptions{Name: "api"})
+	require.NoError(t, err)
+	require.Equal(t, "api", record.Name)
+
+	firewall, err := client.CreateFirewall(ctx, linodego.FirewallCreateOptions{Label: "fw"})
+	require.NoError(t, err)
+	require.Equal(t, linodego.FirewallEnabled, firewall.Status)
+
+	rules, err := client.UpdateFirewallRules(ctx, firewall.ID, linodego.FirewallRulesUpdateOptions{InboundPolicy: "DROP", OutboundPolicy: "ACCEPT"})
+	require.NoError(t, err)
+	require.Equal(t, "DROP", rules.InboundPolicy)
+
+	nodebalancer, err := client.CreateNodeBalancer(ctx, linodego.NodeBalancerCreateOptions{Region: "us-east"})
+	require.NoError(t, err)
+	require.NotNil(t, nodebalancer.IPv4)
+
+	config, err := client.CreateNodeBalancerConfig(ctx, nodebalancer.ID, linodego.NodeBalancerConfigCreateOptions{Port: 443})
+	require.NoError(t, err)
+	require.Equal(t, 443, config.Port)
+
+	events, err := client.ListEvents(ctx, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	require.Greater(t, events[0].ID, events[len(events)-1].ID, "expected events to be ordered newest first")
+}
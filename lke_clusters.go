@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -184,8 +185,8 @@ func (c *Client) ListLKEVersions(ctx context.Context, opts *ListOptions) ([]LKEV
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]LKEVersion), nil
+	if result := getCachedResponse[[]LKEVersion](ctx, c, endpoint); result != nil {
+		return *result, nil
 	}
 
 	response, err := getPaginatedResults[LKEVersion](ctx, c, e, opts)
@@ -193,18 +194,23 @@ func (c *Client) ListLKEVersions(ctx context.Context, opts *ListOptions) ([]LKEV
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
 
+// IterLKEVersions returns an iterator over the results of ListLKEVersions,
+// fetching pages lazily as they are consumed. Unlike ListLKEVersions, results are not cached.
+func (c *Client) IterLKEVersions(ctx context.Context, opts *ListOptions) iter.Seq2[LKEVersion, error] {
+	return getPaginatedIter[LKEVersion](ctx, c, "lke/versions", opts)
+}
+
 // GetLKEVersion gets details about a specific LKE Version. This endpoint is cached by default.
 func (c *Client) GetLKEVersion(ctx context.Context, version string) (*LKEVersion, error) {
 	e := formatAPIPath("lke/versions/%s", version)
 
-	if result := c.getCachedResponse(e); result != nil {
-		result := result.(LKEVersion)
-		return &result, nil
+	if result := getCachedResponse[LKEVersion](ctx, c, e); result != nil {
+		return result, nil
 	}
 
 	response, err := doGETRequest[LKEVersion](ctx, c, e)
@@ -212,7 +218,7 @@ func (c *Client) GetLKEVersion(ctx context.Context, version string) (*LKEVersion
 		return nil, err
 	}
 
-	c.addCachedResponse(e, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, e, response, &cacheExpiryTime)
 
 	return response, nil
 }
@@ -223,6 +229,12 @@ func (c *Client) ListLKETierVersions(ctx context.Context, tier string, opts *Lis
 	return getPaginatedResults[LKETierVersion](ctx, c, formatAPIPath("lke/tiers/%s/versions", tier), opts)
 }
 
+// IterLKETierVersions returns an iterator over the results of ListLKETierVersions,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterLKETierVersions(ctx context.Context, tier string, opts *ListOptions) iter.Seq2[LKETierVersion, error] {
+	return getPaginatedIter[LKETierVersion](ctx, c, formatAPIPath("lke/tiers/%s/versions", tier), opts)
+}
+
 // GetLKETierVersion gets the details of a specific LKE tier version.
 // NOTE: This endpoint may not currently be available to all users and can only be used with v4beta.
 func (c *Client) GetLKETierVersion(ctx context.Context, tier string, versionID string) (*LKETierVersion, error) {
@@ -234,11 +246,23 @@ func (c *Client) ListLKEClusterAPIEndpoints(ctx context.Context, clusterID int,
 	return getPaginatedResults[LKEClusterAPIEndpoint](ctx, c, formatAPIPath("lke/clusters/%d/api-endpoints", clusterID), opts)
 }
 
+// IterLKEClusterAPIEndpoints returns an iterator over the results of ListLKEClusterAPIEndpoints,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterLKEClusterAPIEndpoints(ctx context.Context, clusterID int, opts *ListOptions) iter.Seq2[LKEClusterAPIEndpoint, error] {
+	return getPaginatedIter[LKEClusterAPIEndpoint](ctx, c, formatAPIPath("lke/clusters/%d/api-endpoints", clusterID), opts)
+}
+
 // ListLKEClusters lists LKEClusters
 func (c *Client) ListLKEClusters(ctx context.Context, opts *ListOptions) ([]LKECluster, error) {
 	return getPaginatedResults[LKECluster](ctx, c, "lke/clusters", opts)
 }
 
+// IterLKEClusters returns an iterator over the results of ListLKEClusters,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterLKEClusters(ctx context.Context, opts *ListOptions) iter.Seq2[LKECluster, error] {
+	return getPaginatedIter[LKECluster](ctx, c, "lke/clusters", opts)
+}
+
 // GetLKECluster gets the lkeCluster with the provided ID
 func (c *Client) GetLKECluster(ctx context.Context, clusterID int) (*LKECluster, error) {
 	e := formatAPIPath("lke/clusters/%d", clusterID)
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // LKELinodeStatus constants start with LKELinode and include
@@ -189,6 +190,12 @@ func (c *Client) ListLKENodePools(ctx context.Context, clusterID int, opts *List
 	return getPaginatedResults[LKENodePool](ctx, c, formatAPIPath("lke/clusters/%d/pools", clusterID), opts)
 }
 
+// IterLKENodePools returns an iterator over the results of ListLKENodePools,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterLKENodePools(ctx context.Context, clusterID int, opts *ListOptions) iter.Seq2[LKENodePool, error] {
+	return getPaginatedIter[LKENodePool](ctx, c, formatAPIPath("lke/clusters/%d/pools", clusterID), opts)
+}
+
 // GetLKENodePool gets the LKENodePool with the provided ID
 func (c *Client) GetLKENodePool(ctx context.Context, clusterID, poolID int) (*LKENodePool, error) {
 	e := formatAPIPath("lke/clusters/%d/pools/%d", clusterID, poolID)
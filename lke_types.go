@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // LKEType represents a single valid LKE type.
@@ -32,8 +33,8 @@ func (c *Client) ListLKETypes(ctx context.Context, opts *ListOptions) ([]LKEType
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]LKEType), nil
+	if result := getCachedResponse[[]LKEType](ctx, c, endpoint); result != nil {
+		return *result, nil
 	}
 
 	response, err := getPaginatedResults[LKEType](ctx, c, e, opts)
@@ -41,7 +42,13 @@ func (c *Client) ListLKETypes(ctx context.Context, opts *ListOptions) ([]LKEType
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
+
+// IterLKETypes returns an iterator over the results of ListLKETypes,
+// fetching pages lazily as they are consumed. Unlike ListLKETypes, results are not cached.
+func (c *Client) IterLKETypes(ctx context.Context, opts *ListOptions) iter.Seq2[LKEType, error] {
+	return getPaginatedIter[LKEType](ctx, c, "lke/types", opts)
+}
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // LockType represents the type of lock that can be applied to a resource
@@ -45,6 +46,12 @@ func (c *Client) ListLocks(ctx context.Context, opts *ListOptions) ([]Lock, erro
 	return getPaginatedResults[Lock](ctx, c, "locks", opts)
 }
 
+// IterLocks returns an iterator over the results of ListLocks,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterLocks(ctx context.Context, opts *ListOptions) iter.Seq2[Lock, error] {
+	return getPaginatedIter[Lock](ctx, c, "locks", opts)
+}
+
 // GetLock gets a single Lock with the provided ID
 // NOTE: Locks can only be used with v4beta.
 func (c *Client) GetLock(ctx context.Context, lockID int) (*Lock, error) {
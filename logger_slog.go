@@ -0,0 +1,131 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultSlogMaxBodyLength is the default maximum number of bytes of a request
+// or response body included in structured debug logs.
+const DefaultSlogMaxBodyLength = 4096
+
+// SlogLogger is a Logger backed by a log/slog Logger.
+// When used as a client's logger, debug request and response logs are emitted
+// as structured attributes rather than formatted text.
+type SlogLogger struct {
+	Logger *slog.Logger
+
+	// MaxBodyLength is the maximum number of bytes of a request or response body
+	// to include in debug logs. Longer bodies are truncated. If negative, bodies
+	// are omitted entirely.
+	MaxBodyLength int
+}
+
+var _ Logger = (*SlogLogger)(nil)
+
+// NewSlogLogger creates a new SlogLogger using the given slog Logger.
+// If logger is nil, slog.Default() is used.
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &SlogLogger{
+		Logger:        logger,
+		MaxBodyLength: DefaultSlogMaxBodyLength,
+	}
+}
+
+func (l *SlogLogger) Errorf(format string, v ...any) {
+	l.Logger.Error(fmt.Sprintf(format, v...))
+}
+
+func (l *SlogLogger) Warnf(format string, v ...any) {
+	l.Logger.Warn(fmt.Sprintf(format, v...))
+}
+
+func (l *SlogLogger) Debugf(format string, v ...any) {
+	l.Logger.Debug(fmt.Sprintf(format, v...))
+}
+
+// SetSlogHandler sets the client's logger to a SlogLogger writing to the given handler.
+// Debug logging must be enabled with SetDebug for requests and responses to be logged.
+func (c *Client) SetSlogHandler(handler slog.Handler) *Client {
+	return c.SetLogger(NewSlogLogger(slog.New(handler)))
+}
+
+// SetSlogHandler sets the client's logger to a SlogLogger writing to the given handler.
+// Debug logging must be enabled with SetDebug for requests and responses to be logged.
+func (mc *MonitorClient) SetSlogHandler(handler slog.Handler) *MonitorClient {
+	return mc.SetLogger(NewSlogLogger(slog.New(handler)))
+}
+
+func (l *SlogLogger) logRequest(req *http.Request, headers http.Header, body string) {
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slogHeaderAttr(headers),
+	}
+
+	if l.MaxBodyLength >= 0 {
+		attrs = append(attrs, slog.String("body", truncateLogBody(body, l.MaxBodyLength)))
+	}
+
+	l.Logger.LogAttrs(req.Context(), slog.LevelDebug, "linodego request", attrs...)
+}
+
+func (l *SlogLogger) logResponse(resp *http.Response, headers http.Header, body string, duration time.Duration) {
+	attrs := make([]slog.Attr, 0, 6)
+	ctx := context.Background()
+
+	if resp.Request != nil {
+		ctx = resp.Request.Context()
+		attrs = append(attrs,
+			slog.String("method", resp.Request.Method),
+			slog.String("url", resp.Request.URL.String()),
+		)
+	}
+
+	attrs = append(attrs,
+		slog.Int("status", resp.StatusCode),
+		slog.Duration("duration", duration),
+		slogHeaderAttr(headers),
+	)
+
+	if l.MaxBodyLength >= 0 {
+		attrs = append(attrs, slog.String("body", truncateLogBody(body, l.MaxBodyLength)))
+	}
+
+	l.Logger.LogAttrs(ctx, slog.LevelDebug, "linodego response", attrs...)
+}
+
+func slogHeaderAttr(headers http.Header) slog.Attr {
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	attrs := make([]any, len(keys))
+	for i, key := range keys {
+		attrs[i] = slog.String(key, strings.Join(headers[key], ", "))
+	}
+
+	return slog.Group("headers", attrs...)
+}
+
+func truncateLogBody(body string, maxLength int) string {
+	body = strings.TrimSpace(body)
+
+	if maxLength > 0 && len(body) > maxLength {
+		return body[:maxLength] + "...(truncated)"
+	}
+
+	return body
+}
@@ -0,0 +1,101 @@
+package linodego
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func decodeSlogRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+
+	for line := range strings.Lines(buf.String()) {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func TestClient_SetSlogHandler(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":123,"label":"` + strings.Repeat("a", 64) + `"}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	var buf bytes.Buffer
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL)
+	client.SetToken("secret-token")
+	client.SetDebug(true)
+	client.SetSlogHandler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client.logger.(*SlogLogger).MaxBodyLength = 16
+
+	var result map[string]any
+
+	require.NoError(t, client.doRequest(context.Background(), http.MethodGet, "/test", requestParams{Response: &result}, nil))
+	require.EqualValues(t, 123, result["id"])
+
+	records := decodeSlogRecords(t, &buf)
+	require.Len(t, records, 2)
+
+	req := records[0]
+	require.Equal(t, "linodego request", req["msg"])
+	require.Equal(t, http.MethodGet, req["method"])
+	require.Contains(t, req["url"], "/test")
+	require.NotContains(t, buf.String(), "secret-token")
+
+	resp := records[1]
+	require.Equal(t, "linodego response", resp["msg"])
+	require.EqualValues(t, http.StatusOK, resp["status"])
+	require.Contains(t, resp, "duration")
+	require.Equal(t, "application/json", resp["headers"].(map[string]any)["Content-Type"])
+	require.Equal(t, `{"id":123,"label...(truncated)`, resp["body"])
+}
+
+func TestMonitorClient_SetSlogHandler(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"token":"abc"}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	var buf bytes.Buffer
+
+	client := NewMonitorClient(nil)
+	client.SetBaseURL(server.URL)
+	client.SetToken("secret-token")
+	client.SetDebug(true)
+	client.SetSlogHandler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	var result map[string]any
+
+	require.NoError(t, client.doRequest(context.Background(), http.MethodPost, "/test", requestParams{Response: &result}))
+	require.Equal(t, "abc", result["token"])
+
+	records := decodeSlogRecords(t, &buf)
+	require.Len(t, records, 2)
+	require.Equal(t, http.MethodPost, records[0]["method"])
+	require.Equal(t, "Bearer *******************************", records[0]["headers"].(map[string]any)["Authorization"])
+	require.EqualValues(t, http.StatusOK, records[1]["status"])
+	require.Equal(t, `{"token":"abc"}`, records[1]["body"])
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -54,6 +55,12 @@ func (c *Client) ListLongviewClients(ctx context.Context, opts *ListOptions) ([]
 	return getPaginatedResults[LongviewClient](ctx, c, "longview/clients", opts)
 }
 
+// IterLongviewClients returns an iterator over the results of ListLongviewClients,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterLongviewClients(ctx context.Context, opts *ListOptions) iter.Seq2[LongviewClient, error] {
+	return getPaginatedIter[LongviewClient](ctx, c, "longview/clients", opts)
+}
+
 // GetLongviewClient gets the template with the provided ID
 func (c *Client) GetLongviewClient(ctx context.Context, clientID int) (*LongviewClient, error) {
 	e := formatAPIPath("longview/clients/%d", clientID)
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // LongviewSubscription represents a LongviewSubscription object
@@ -19,6 +20,12 @@ func (c *Client) ListLongviewSubscriptions(ctx context.Context, opts *ListOption
 	return getPaginatedResults[LongviewSubscription](ctx, c, "longview/subscriptions", opts)
 }
 
+// IterLongviewSubscriptions returns an iterator over the results of ListLongviewSubscriptions,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterLongviewSubscriptions(ctx context.Context, opts *ListOptions) iter.Seq2[LongviewSubscription, error] {
+	return getPaginatedIter[LongviewSubscription](ctx, c, "longview/subscriptions", opts)
+}
+
 // GetLongviewSubscription gets the template with the provided ID
 func (c *Client) GetLongviewSubscription(ctx context.Context, templateID string) (*LongviewSubscription, error) {
 	e := formatAPIPath("longview/subscriptions/%s", templateID)
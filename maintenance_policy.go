@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 type MaintenancePolicy struct {
@@ -17,3 +18,9 @@ type MaintenancePolicy struct {
 func (c *Client) ListMaintenancePolicies(ctx context.Context, opts *ListOptions) ([]MaintenancePolicy, error) {
 	return getPaginatedResults[MaintenancePolicy](ctx, c, "maintenance/policies", opts)
 }
+
+// IterMaintenancePolicies returns an iterator over the results of ListMaintenancePolicies,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterMaintenancePolicies(ctx context.Context, opts *ListOptions) iter.Seq2[MaintenancePolicy, error] {
+	return getPaginatedIter[MaintenancePolicy](ctx, c, "maintenance/policies", opts)
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -82,3 +83,10 @@ func (c *Client) ListAlertChannels(ctx context.Context, opts *ListOptions) ([]Al
 	endpoint := formatAPIPath("monitor/alert-channels")
 	return getPaginatedResults[AlertChannel](ctx, c, endpoint, opts)
 }
+
+// IterAlertChannels returns an iterator over the results of ListAlertChannels,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterAlertChannels(ctx context.Context, opts *ListOptions) iter.Seq2[AlertChannel, error] {
+	endpoint := formatAPIPath("monitor/alert-channels")
+	return getPaginatedIter[AlertChannel](ctx, c, endpoint, opts)
+}
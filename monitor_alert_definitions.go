@@ -1,10 +1,9 @@
 package linodego
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"net/http"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -232,6 +231,17 @@ func (c *Client) ListMonitorAlertDefinitions(
 	return getPaginatedResults[AlertDefinition](ctx, c, endpoint, opts)
 }
 
+// IterMonitorAlertDefinitions returns an iterator over the results of ListMonitorAlertDefinitions,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterMonitorAlertDefinitions(
+	ctx context.Context,
+	serviceType string,
+	opts *ListOptions,
+) iter.Seq2[AlertDefinition, error] {
+	endpoint := formatAPIPath("monitor/services/%s/alert-definitions", serviceType)
+	return getPaginatedIter[AlertDefinition](ctx, c, endpoint, opts)
+}
+
 // ListAllMonitorAlertDefinitions returns a paginated list of all ACLP Monitor Alert Definitions under this account.
 func (c *Client) ListAllMonitorAlertDefinitions(
 	ctx context.Context,
@@ -241,6 +251,16 @@ func (c *Client) ListAllMonitorAlertDefinitions(
 	return getPaginatedResults[AlertDefinition](ctx, c, endpoint, opts)
 }
 
+// IterAllMonitorAlertDefinitions returns an iterator over the results of ListAllMonitorAlertDefinitions,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterAllMonitorAlertDefinitions(
+	ctx context.Context,
+	opts *ListOptions,
+) iter.Seq2[AlertDefinition, error] {
+	endpoint := formatAPIPath("monitor/alert-definitions")
+	return getPaginatedIter[AlertDefinition](ctx, c, endpoint, opts)
+}
+
 // GetMonitorAlertDefinition gets an ACLP Monitor Alert Definition.
 func (c *Client) GetMonitorAlertDefinition(
 	ctx context.Context,
@@ -263,38 +283,18 @@ func (c *Client) CreateMonitorAlertDefinition(
 
 // CreateMonitorAlertDefinitionWithIdempotency creates an ACLP Monitor Alert Definition
 // and optionally sends an Idempotency-Key header to make the request idempotent.
+// It is equivalent to calling CreateMonitorAlertDefinition with a context from WithIdempotencyKey.
 func (c *Client) CreateMonitorAlertDefinitionWithIdempotency(
 	ctx context.Context,
 	serviceType string,
 	opts AlertDefinitionCreateOptions,
 	idempotencyKey string,
 ) (*AlertDefinition, error) {
-	e := formatAPIPath("monitor/services/%s/alert-definitions", serviceType)
-
-	var result AlertDefinition
-
-	body, err := json.Marshal(opts)
-	if err != nil {
-		return nil, err
-	}
-
-	params := requestParams{
-		Response: &result,
-		Body:     bytes.NewReader(body),
-	}
-
 	if idempotencyKey != "" {
-		params.Headers = http.Header{
-			"Idempotency-Key": {idempotencyKey},
-		}
+		ctx = WithIdempotencyKey(ctx, idempotencyKey)
 	}
 
-	err = c.doRequest(ctx, http.MethodPost, e, params, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	return c.CreateMonitorAlertDefinition(ctx, serviceType, opts)
 }
 
 // UpdateMonitorAlertDefinition updates an ACLP Monitor Alert Definition.
@@ -325,6 +325,18 @@ func (c *Client) ListMonitorAlertDefinitionEntities(
 	return getPaginatedResults[AlertDefinitionEntity](ctx, c, e, opts)
 }
 
+// IterMonitorAlertDefinitionEntities returns an iterator over the results of ListMonitorAlertDefinitionEntities,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterMonitorAlertDefinitionEntities(
+	ctx context.Context,
+	serviceType string,
+	alertID int,
+	opts *ListOptions,
+) iter.Seq2[AlertDefinitionEntity, error] {
+	e := formatAPIPath("monitor/services/%s/alert-definitions/%d/entities", serviceType, alertID)
+	return getPaginatedIter[AlertDefinitionEntity](ctx, c, e, opts)
+}
+
 // CloneMonitorAlertDefinition clones an ACLP Monitor Alert Definition.
 func (c *Client) CloneMonitorAlertDefinition(
 	ctx context.Context,
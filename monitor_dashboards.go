@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -91,6 +92,12 @@ func (c *Client) ListMonitorDashboards(ctx context.Context, opts *ListOptions) (
 	return getPaginatedResults[MonitorDashboard](ctx, c, "monitor/dashboards", opts)
 }
 
+// IterMonitorDashboards returns an iterator over the results of ListMonitorDashboards,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterMonitorDashboards(ctx context.Context, opts *ListOptions) iter.Seq2[MonitorDashboard, error] {
+	return getPaginatedIter[MonitorDashboard](ctx, c, "monitor/dashboards", opts)
+}
+
 // GetMonitorDashboard gets an ACLP Monitor Dashboard for a given dashboardID
 func (c *Client) GetMonitorDashboard(ctx context.Context, dashboardID int) (*MonitorDashboard, error) {
 	e := formatAPIPath("monitor/dashboards/%d", dashboardID)
@@ -103,6 +110,13 @@ func (c *Client) ListMonitorDashboardsByServiceType(ctx context.Context, service
 	return getPaginatedResults[MonitorDashboard](ctx, c, e, opts)
 }
 
+// IterMonitorDashboardsByServiceType returns an iterator over the results of ListMonitorDashboardsByServiceType,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterMonitorDashboardsByServiceType(ctx context.Context, serviceType string, opts *ListOptions) iter.Seq2[MonitorDashboard, error] {
+	e := formatAPIPath("monitor/services/%s/dashboards", serviceType)
+	return getPaginatedIter[MonitorDashboard](ctx, c, e, opts)
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (i *MonitorDashboard) UnmarshalJSON(b []byte) error {
 	type Mask MonitorDashboard
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -178,6 +179,12 @@ func (c *Client) ListLogsDestinations(ctx context.Context, opts *ListOptions) ([
 	return getPaginatedResults[LogsDestination](ctx, c, logsDestinationBaseEndpoint, opts)
 }
 
+// IterLogsDestinations returns an iterator over the results of ListLogsDestinations,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterLogsDestinations(ctx context.Context, opts *ListOptions) iter.Seq2[LogsDestination, error] {
+	return getPaginatedIter[LogsDestination](ctx, c, logsDestinationBaseEndpoint, opts)
+}
+
 // GetLogsDestination gets a single logs destination by ID.
 func (c *Client) GetLogsDestination(ctx context.Context, destinationID int) (*LogsDestination, error) {
 	e := formatAPIPath(logsDestinationBaseEndpoint+"/%d", destinationID)
@@ -206,3 +213,10 @@ func (c *Client) ListLogsDestinationHistory(ctx context.Context, destinationID i
 	e := formatAPIPath(logsDestinationBaseEndpoint+"/%d/history", destinationID)
 	return getPaginatedResults[LogsDestination](ctx, c, e, opts)
 }
+
+// IterLogsDestinationHistory returns an iterator over the results of ListLogsDestinationHistory,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterLogsDestinationHistory(ctx context.Context, destinationID int, opts *ListOptions) iter.Seq2[LogsDestination, error] {
+	e := formatAPIPath(logsDestinationBaseEndpoint+"/%d/history", destinationID)
+	return getPaginatedIter[LogsDestination](ctx, c, e, opts)
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -117,6 +118,12 @@ func (c *Client) ListLogStreams(ctx context.Context, opts *ListOptions) ([]Strea
 	return getPaginatedResults[Stream](ctx, c, "monitor/streams", opts)
 }
 
+// IterLogStreams returns an iterator over the results of ListLogStreams,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterLogStreams(ctx context.Context, opts *ListOptions) iter.Seq2[Stream, error] {
+	return getPaginatedIter[Stream](ctx, c, "monitor/streams", opts)
+}
+
 // GetLogStream returns a single ACLP logs stream by ID.
 func (c *Client) GetLogStream(ctx context.Context, streamID int) (*Stream, error) {
 	e := formatAPIPath("monitor/streams/%d", streamID)
@@ -141,6 +148,13 @@ func (c *Client) ListLogStreamHistory(ctx context.Context, streamID int, opts *L
 	return getPaginatedResults[Stream](ctx, c, e, opts)
 }
 
+// IterLogStreamHistory returns an iterator over the results of ListLogStreamHistory,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterLogStreamHistory(ctx context.Context, streamID int, opts *ListOptions) iter.Seq2[Stream, error] {
+	e := formatAPIPath("monitor/streams/%d/history", streamID)
+	return getPaginatedIter[Stream](ctx, c, e, opts)
+}
+
 // DeleteLogStream deletes an ACLP logs stream by ID.
 func (c *Client) DeleteLogStream(ctx context.Context, streamID int) error {
 	e := formatAPIPath("monitor/streams/%d", streamID)
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // MonitorMetricsDefinition represents an ACLP MetricsDefinition object
@@ -63,3 +64,10 @@ func (c *Client) ListMonitorMetricsDefinitionByServiceType(ctx context.Context,
 	e := formatAPIPath("monitor/services/%s/metric-definitions", serviceType)
 	return getPaginatedResults[MonitorMetricsDefinition](ctx, c, e, opts)
 }
+
+// IterMonitorMetricsDefinitionByServiceType returns an iterator over the results of ListMonitorMetricsDefinitionByServiceType,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterMonitorMetricsDefinitionByServiceType(ctx context.Context, serviceType string, opts *ListOptions) iter.Seq2[MonitorMetricsDefinition, error] {
+	e := formatAPIPath("monitor/services/%s/metric-definitions", serviceType)
+	return getPaginatedIter[MonitorMetricsDefinition](ctx, c, e, opts)
+}
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // MonitorService represents a MonitorService object
@@ -23,6 +24,12 @@ func (c *Client) ListMonitorServices(ctx context.Context, opts *ListOptions) ([]
 	return getPaginatedResults[MonitorService](ctx, c, "monitor/services", opts)
 }
 
+// IterMonitorServices returns an iterator over the results of ListMonitorServices,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterMonitorServices(ctx context.Context, opts *ListOptions) iter.Seq2[MonitorService, error] {
+	return getPaginatedIter[MonitorService](ctx, c, "monitor/services", opts)
+}
+
 // GetMonitorServiceByType gets a monitor service by a given service_type
 func (c *Client) GetMonitorServiceByType(ctx context.Context, serviceType string) (*MonitorService, error) {
 	e := formatAPIPath("monitor/services/%s", serviceType)
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -433,6 +434,12 @@ func (c *Client) ListMySQLDatabases(ctx context.Context, opts *ListOptions) ([]M
 	return getPaginatedResults[MySQLDatabase](ctx, c, "databases/mysql/instances", opts)
 }
 
+// IterMySQLDatabases returns an iterator over the results of ListMySQLDatabases,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterMySQLDatabases(ctx context.Context, opts *ListOptions) iter.Seq2[MySQLDatabase, error] {
+	return getPaginatedIter[MySQLDatabase](ctx, c, "databases/mysql/instances", opts)
+}
+
 // GetMySQLDatabase returns a single MySQL Database matching the id
 func (c *Client) GetMySQLDatabase(ctx context.Context, databaseID int) (*MySQLDatabase, error) {
 	e := formatAPIPath("databases/mysql/instances/%d", databaseID)
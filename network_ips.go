@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // IPAddressUpdateOptions fields are those accepted by UpdateIPAddress.
@@ -64,6 +65,12 @@ func (c *Client) ListIPAddresses(ctx context.Context, opts *ListOptions) ([]Inst
 	return getPaginatedResults[InstanceIP](ctx, c, "networking/ips", opts)
 }
 
+// IterIPAddresses returns an iterator over the results of ListIPAddresses,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterIPAddresses(ctx context.Context, opts *ListOptions) iter.Seq2[InstanceIP, error] {
+	return getPaginatedIter[InstanceIP](ctx, c, "networking/ips", opts)
+}
+
 // GetIPAddress gets the IPAddress with the provided IP.
 func (c *Client) GetIPAddress(ctx context.Context, id string) (*InstanceIP, error) {
 	e := formatAPIPath("networking/ips/%s", id)
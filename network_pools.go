@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // ListIPv6Pools lists IPv6Pools
@@ -9,6 +10,12 @@ func (c *Client) ListIPv6Pools(ctx context.Context, opts *ListOptions) ([]IPv6Ra
 	return getPaginatedResults[IPv6Range](ctx, c, "networking/ipv6/pools", opts)
 }
 
+// IterIPv6Pools returns an iterator over the results of ListIPv6Pools,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterIPv6Pools(ctx context.Context, opts *ListOptions) iter.Seq2[IPv6Range, error] {
+	return getPaginatedIter[IPv6Range](ctx, c, "networking/ipv6/pools", opts)
+}
+
 // GetIPv6Pool gets the template with the provided ID
 func (c *Client) GetIPv6Pool(ctx context.Context, id string) (*IPv6Range, error) {
 	e := formatAPIPath("networking/ipv6/pools/%s", id)
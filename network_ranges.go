@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // IPv6RangeCreateOptions fields are those accepted by CreateIPv6Range
@@ -16,6 +17,12 @@ func (c *Client) ListIPv6Ranges(ctx context.Context, opts *ListOptions) ([]IPv6R
 	return getPaginatedResults[IPv6Range](ctx, c, "networking/ipv6/ranges", opts)
 }
 
+// IterIPv6Ranges returns an iterator over the results of ListIPv6Ranges,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterIPv6Ranges(ctx context.Context, opts *ListOptions) iter.Seq2[IPv6Range, error] {
+	return getPaginatedIter[IPv6Range](ctx, c, "networking/ipv6/ranges", opts)
+}
+
 // GetIPv6Range gets details about an IPv6 range
 func (c *Client) GetIPv6Range(ctx context.Context, ipRange string) (*IPv6Range, error) {
 	e := formatAPIPath("networking/ipv6/ranges/%s", ipRange)
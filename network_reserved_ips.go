@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // ReservedIPAssignedEntity represents the entity that a reserved IP is assigned to.
@@ -45,6 +46,13 @@ func (c *Client) ListReservedIPAddresses(ctx context.Context, opts *ListOptions)
 	return getPaginatedResults[InstanceIP](ctx, c, e, opts)
 }
 
+// IterReservedIPAddresses returns an iterator over the results of ListReservedIPAddresses,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterReservedIPAddresses(ctx context.Context, opts *ListOptions) iter.Seq2[InstanceIP, error] {
+	e := formatAPIPath("networking/reserved/ips")
+	return getPaginatedIter[InstanceIP](ctx, c, e, opts)
+}
+
 // GetReservedIPAddress retrieves details of a specific reserved IP address
 // NOTE: Reserved IP feature may not currently be available to all users.
 func (c *Client) GetReservedIPAddress(ctx context.Context, ipAddress string) (*InstanceIP, error) {
@@ -77,3 +85,9 @@ func (c *Client) DeleteReservedIPAddress(ctx context.Context, ipAddress string)
 func (c *Client) ListReservedIPTypes(ctx context.Context, opts *ListOptions) ([]ReservedIPType, error) {
 	return getPaginatedResults[ReservedIPType](ctx, c, "networking/reserved/ips/types", opts)
 }
+
+// IterReservedIPTypes returns an iterator over the results of ListReservedIPTypes,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterReservedIPTypes(ctx context.Context, opts *ListOptions) iter.Seq2[ReservedIPType, error] {
+	return getPaginatedIter[ReservedIPType](ctx, c, "networking/reserved/ips/types", opts)
+}
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // NetworkTransferPrice represents a single valid network transfer price.
@@ -30,8 +31,8 @@ func (c *Client) ListNetworkTransferPrices(ctx context.Context, opts *ListOption
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]NetworkTransferPrice), nil
+	if result := getCachedResponse[[]NetworkTransferPrice](ctx, c, endpoint); result != nil {
+		return *result, nil
 	}
 
 	response, err := getPaginatedResults[NetworkTransferPrice](ctx, c, e, opts)
@@ -39,7 +40,13 @@ func (c *Client) ListNetworkTransferPrices(ctx context.Context, opts *ListOption
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
+
+// IterNetworkTransferPrices returns an iterator over the results of ListNetworkTransferPrices,
+// fetching pages lazily as they are consumed. Unlike ListNetworkTransferPrices, results are not cached.
+func (c *Client) IterNetworkTransferPrices(ctx context.Context, opts *ListOptions) iter.Seq2[NetworkTransferPrice, error] {
+	return getPaginatedIter[NetworkTransferPrice](ctx, c, "network-transfer/prices", opts)
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -165,6 +166,12 @@ func (c *Client) ListNodeBalancers(ctx context.Context, opts *ListOptions) ([]No
 	return getPaginatedResults[NodeBalancer](ctx, c, "nodebalancers", opts)
 }
 
+// IterNodeBalancers returns an iterator over the results of ListNodeBalancers,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterNodeBalancers(ctx context.Context, opts *ListOptions) iter.Seq2[NodeBalancer, error] {
+	return getPaginatedIter[NodeBalancer](ctx, c, "nodebalancers", opts)
+}
+
 // GetNodeBalancer gets the NodeBalancer with the provided ID
 func (c *Client) GetNodeBalancer(ctx context.Context, nodebalancerID int) (*NodeBalancer, error) {
 	e := formatAPIPath("nodebalancers/%d", nodebalancerID)
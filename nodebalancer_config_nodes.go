@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // NodeBalancerNode objects represent a backend that can accept traffic for a NodeBalancer Config
@@ -77,6 +78,12 @@ func (c *Client) ListNodeBalancerNodes(ctx context.Context, nodebalancerID int,
 	return getPaginatedResults[NodeBalancerNode](ctx, c, formatAPIPath("nodebalancers/%d/configs/%d/nodes", nodebalancerID, configID), opts)
 }
 
+// IterNodeBalancerNodes returns an iterator over the results of ListNodeBalancerNodes,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterNodeBalancerNodes(ctx context.Context, nodebalancerID int, configID int, opts *ListOptions) iter.Seq2[NodeBalancerNode, error] {
+	return getPaginatedIter[NodeBalancerNode](ctx, c, formatAPIPath("nodebalancers/%d/configs/%d/nodes", nodebalancerID, configID), opts)
+}
+
 // GetNodeBalancerNode gets the template with the provided ID
 func (c *Client) GetNodeBalancerNode(ctx context.Context, nodebalancerID int, configID int, nodeID int) (*NodeBalancerNode, error) {
 	e := formatAPIPath("nodebalancers/%d/configs/%d/nodes/%d", nodebalancerID, configID, nodeID)
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // NodeBalancerVPCConfig objects represent a VPC config for a NodeBalancer
@@ -21,6 +22,12 @@ func (c *Client) ListNodeBalancerVPCConfigs(ctx context.Context, nodebalancerID
 	return getPaginatedResults[NodeBalancerVPCConfig](ctx, c, formatAPIPath("nodebalancers/%d/vpcs", nodebalancerID), opts)
 }
 
+// IterNodeBalancerVPCConfigs returns an iterator over the results of ListNodeBalancerVPCConfigs,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterNodeBalancerVPCConfigs(ctx context.Context, nodebalancerID int, opts *ListOptions) iter.Seq2[NodeBalancerVPCConfig, error] {
+	return getPaginatedIter[NodeBalancerVPCConfig](ctx, c, formatAPIPath("nodebalancers/%d/vpcs", nodebalancerID), opts)
+}
+
 // GetNodeBalancerVPCConfig gets the NodeBalancer VPC config with the specified id
 func (c *Client) GetNodeBalancerVPCConfig(ctx context.Context, nodebalancerID int, vpcID int) (*NodeBalancerVPCConfig, error) {
 	e := formatAPIPath("nodebalancers/%d/vpcs/%d", nodebalancerID, vpcID)
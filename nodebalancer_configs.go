@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // NodeBalancerConfig objects allow a NodeBalancer to accept traffic on a new port
@@ -251,6 +252,12 @@ func (c *Client) ListNodeBalancerConfigs(ctx context.Context, nodebalancerID int
 	return getPaginatedResults[NodeBalancerConfig](ctx, c, formatAPIPath("nodebalancers/%d/configs", nodebalancerID), opts)
 }
 
+// IterNodeBalancerConfigs returns an iterator over the results of ListNodeBalancerConfigs,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterNodeBalancerConfigs(ctx context.Context, nodebalancerID int, opts *ListOptions) iter.Seq2[NodeBalancerConfig, error] {
+	return getPaginatedIter[NodeBalancerConfig](ctx, c, formatAPIPath("nodebalancers/%d/configs", nodebalancerID), opts)
+}
+
 // GetNodeBalancerConfig gets the template with the provided ID
 func (c *Client) GetNodeBalancerConfig(ctx context.Context, nodebalancerID int, configID int) (*NodeBalancerConfig, error) {
 	e := formatAPIPath("nodebalancers/%d/configs/%d", nodebalancerID, configID)
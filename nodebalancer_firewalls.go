@@ -2,9 +2,16 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // ListNodeBalancerFirewalls returns a paginated list of Cloud Firewalls for nodebalancerID
 func (c *Client) ListNodeBalancerFirewalls(ctx context.Context, nodebalancerID int, opts *ListOptions) ([]Firewall, error) {
 	return getPaginatedResults[Firewall](ctx, c, formatAPIPath("nodebalancers/%d/firewalls", nodebalancerID), opts)
 }
+
+// IterNodeBalancerFirewalls returns an iterator over the results of ListNodeBalancerFirewalls,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterNodeBalancerFirewalls(ctx context.Context, nodebalancerID int, opts *ListOptions) iter.Seq2[Firewall, error] {
+	return getPaginatedIter[Firewall](ctx, c, formatAPIPath("nodebalancers/%d/firewalls", nodebalancerID), opts)
+}
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // NodeBalancerType represents a single valid NodeBalancer type.
@@ -30,8 +31,8 @@ func (c *Client) ListNodeBalancerTypes(ctx context.Context, opts *ListOptions) (
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]NodeBalancerType), nil
+	if result := getCachedResponse[[]NodeBalancerType](ctx, c, endpoint); result != nil {
+		return *result, nil
 	}
 
 	response, err := getPaginatedResults[NodeBalancerType](ctx, c, e, opts)
@@ -39,7 +40,13 @@ func (c *Client) ListNodeBalancerTypes(ctx context.Context, opts *ListOptions) (
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
+
+// IterNodeBalancerTypes returns an iterator over the results of ListNodeBalancerTypes,
+// fetching pages lazily as they are consumed. Unlike ListNodeBalancerTypes, results are not cached.
+func (c *Client) IterNodeBalancerTypes(ctx context.Context, opts *ListOptions) iter.Seq2[NodeBalancerType, error] {
+	return getPaginatedIter[NodeBalancerType](ctx, c, "nodebalancers/types", opts)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -116,11 +117,23 @@ func (c *Client) ListObjectStorageBuckets(ctx context.Context, opts *ListOptions
 	return getPaginatedResults[ObjectStorageBucket](ctx, c, "object-storage/buckets", opts)
 }
 
+// IterObjectStorageBuckets returns an iterator over the results of ListObjectStorageBuckets,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterObjectStorageBuckets(ctx context.Context, opts *ListOptions) iter.Seq2[ObjectStorageBucket, error] {
+	return getPaginatedIter[ObjectStorageBucket](ctx, c, "object-storage/buckets", opts)
+}
+
 // ListObjectStorageBucketsInRegion lists all ObjectStorageBuckets in the specified region
 func (c *Client) ListObjectStorageBucketsInRegion(ctx context.Context, opts *ListOptions, regionID string) ([]ObjectStorageBucket, error) {
 	return getPaginatedResults[ObjectStorageBucket](ctx, c, formatAPIPath("object-storage/buckets/%s", regionID), opts)
 }
 
+// IterObjectStorageBucketsInRegion returns an iterator over the results of ListObjectStorageBucketsInRegion,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterObjectStorageBucketsInRegion(ctx context.Context, opts *ListOptions, regionID string) iter.Seq2[ObjectStorageBucket, error] {
+	return getPaginatedIter[ObjectStorageBucket](ctx, c, formatAPIPath("object-storage/buckets/%s", regionID), opts)
+}
+
 // GetObjectStorageBucket gets the ObjectStorageBucket with the provided label
 func (c *Client) GetObjectStorageBucket(ctx context.Context, regionID, label string) (*ObjectStorageBucket, error) {
 	e := formatAPIPath("object-storage/buckets/%s/%s", regionID, label)
@@ -1,6 +1,9 @@
 package linodego
 
-import "context"
+import (
+	"context"
+	"iter"
+)
 
 // ObjectStorageEndpointType constants start with Notification and include all known Linode API Notification Types.
 type ObjectStorageEndpointType string
@@ -24,3 +27,9 @@ type ObjectStorageEndpoint struct {
 func (c *Client) ListObjectStorageEndpoints(ctx context.Context, opts *ListOptions) ([]ObjectStorageEndpoint, error) {
 	return getPaginatedResults[ObjectStorageEndpoint](ctx, c, "object-storage/endpoints", opts)
 }
+
+// IterObjectStorageEndpoints returns an iterator over the results of ListObjectStorageEndpoints,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterObjectStorageEndpoints(ctx context.Context, opts *ListOptions) iter.Seq2[ObjectStorageEndpoint, error] {
+	return getPaginatedIter[ObjectStorageEndpoint](ctx, c, "object-storage/endpoints", opts)
+}
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 type ObjectStorageKeyRegion struct {
@@ -54,6 +55,12 @@ func (c *Client) ListObjectStorageKeys(ctx context.Context, opts *ListOptions) (
 	return getPaginatedResults[ObjectStorageKey](ctx, c, "object-storage/keys", opts)
 }
 
+// IterObjectStorageKeys returns an iterator over the results of ListObjectStorageKeys,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterObjectStorageKeys(ctx context.Context, opts *ListOptions) iter.Seq2[ObjectStorageKey, error] {
+	return getPaginatedIter[ObjectStorageKey](ctx, c, "object-storage/keys", opts)
+}
+
 // CreateObjectStorageKey creates a ObjectStorageKey
 func (c *Client) CreateObjectStorageKey(ctx context.Context, opts ObjectStorageKeyCreateOptions) (*ObjectStorageKey, error) {
 	return doPOSTRequest[ObjectStorageKey](ctx, c, "object-storage/keys", opts)
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // ObjectStorageQuota represents a Object Storage related quota information on your account.
@@ -45,6 +46,12 @@ func (c *Client) ListObjectStorageQuotas(ctx context.Context, opts *ListOptions)
 	return getPaginatedResults[ObjectStorageQuota](ctx, c, formatAPIPath("object-storage/quotas"), opts)
 }
 
+// IterObjectStorageQuotas returns an iterator over the results of ListObjectStorageQuotas,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterObjectStorageQuotas(ctx context.Context, opts *ListOptions) iter.Seq2[ObjectStorageQuota, error] {
+	return getPaginatedIter[ObjectStorageQuota](ctx, c, formatAPIPath("object-storage/quotas"), opts)
+}
+
 // GetObjectStorageQuota gets information about a specific ObjectStorage-related quota on your account.
 func (c *Client) GetObjectStorageQuota(ctx context.Context, quotaID string) (*ObjectStorageQuota, error) {
 	e := formatAPIPath("object-storage/quotas/%s", quotaID)
@@ -62,6 +69,12 @@ func (c *Client) ListObjectStorageGlobalQuotas(ctx context.Context, opts *ListOp
 	return getPaginatedResults[ObjectStorageGlobalQuota](ctx, c, formatAPIPath("object-storage/global-quotas"), opts)
 }
 
+// IterObjectStorageGlobalQuotas returns an iterator over the results of ListObjectStorageGlobalQuotas,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterObjectStorageGlobalQuotas(ctx context.Context, opts *ListOptions) iter.Seq2[ObjectStorageGlobalQuota, error] {
+	return getPaginatedIter[ObjectStorageGlobalQuota](ctx, c, formatAPIPath("object-storage/global-quotas"), opts)
+}
+
 // GetObjectStorageGlobalQuota gets information about a specific global/account-level ObjectStorage-related quota on your account.
 func (c *Client) GetObjectStorageGlobalQuota(ctx context.Context, quotaID string) (*ObjectStorageGlobalQuota, error) {
 	e := formatAPIPath("object-storage/global-quotas/%s", quotaID)
@@ -0,0 +1,273 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// MetricRequestsTotal counts completed API calls, including all retries.
+	MetricRequestsTotal = "linodego_requests_total"
+	// MetricRequestDurationSeconds observes the total duration of API calls.
+	MetricRequestDurationSeconds = "linodego_request_duration_seconds"
+	// MetricRequestAttemptsTotal counts individual attempts, including retries.
+	MetricRequestAttemptsTotal = "linodego_request_attempts_total"
+	// MetricRequestAttemptDurationSeconds observes the duration of individual attempts.
+	MetricRequestAttemptDurationSeconds = "linodego_request_attempt_duration_seconds"
+)
+
+// RequestEvent describes a logical API call or a single attempt of one.
+type RequestEvent struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Endpoint is the resolved endpoint, e.g. "linode/instances/123".
+	Endpoint string
+	// EndpointTemplate is the endpoint with IDs replaced, e.g. "linode/instances/{id}".
+	// See RequestEndpointTemplate.
+	EndpointTemplate string
+	// Attempt is the 1-based attempt number. When a call completes,
+	// this is the total number of attempts that were made.
+	Attempt int
+
+	// The following fields are only populated once the call or attempt completes.
+
+	// StatusCode is the HTTP status code of the last response, or 0 if no response was received.
+	StatusCode int
+	// Duration is the time spent on the call or attempt.
+	Duration time.Duration
+	// Err is the error returned by the call or attempt, if any.
+	Err error
+}
+
+// RequestObserver is notified around each logical API call and each attempt of that call.
+// Implementations must be safe for concurrent use.
+type RequestObserver interface {
+	// StartCall is invoked before the first attempt of a logical API call.
+	// The returned context is used for all attempts of the call, and the returned
+	// function is invoked with the final outcome once the call completes.
+	StartCall(ctx context.Context, event RequestEvent) (context.Context, func(RequestEvent))
+
+	// StartAttempt is invoked before each attempt of a call, including the first.
+	// The returned context is used for the attempt's HTTP request, and the returned
+	// function is invoked with the outcome of the attempt.
+	StartAttempt(ctx context.Context, event RequestEvent) (context.Context, func(RequestEvent))
+}
+
+// endpointNamedIDCollections maps collections whose items are identified by non-numeric IDs,
+// such as slugs, labels and addresses, to the static segments that may follow them.
+// Numeric IDs in the collection paths are written as "{id}".
+var endpointNamedIDCollections = map[string][]string{
+	"account/availability":            nil,
+	"account/betas":                   nil,
+	"account/child-accounts":          nil,
+	"account/entity-transfers":        nil,
+	"account/oauth-clients":           nil,
+	"account/service-transfers":       nil,
+	"account/users":                   nil,
+	"betas":                           nil,
+	"databases/engines":               nil,
+	"databases/types":                 nil,
+	"iam/users":                       nil,
+	"images":                          {"sharegroups", "upload"},
+	"images/sharegroups/tokens":       nil,
+	"images/sharegroups/{id}/images":  nil,
+	"images/sharegroups/{id}/members": nil,
+	"linode/instances/{id}/ips":       nil,
+	"linode/kernels":                  nil,
+	"linode/types":                    nil,
+	"lke/clusters/{id}/nodes":         nil,
+	"lke/tiers":                       nil,
+	"lke/tiers/{id}/versions":         nil,
+	"lke/versions":                    nil,
+	"longview/subscriptions":          nil,
+	"monitor/services":                nil,
+	"networking/firewalls/templates":  nil,
+	"networking/ips":                  {"assign", "share"},
+	"networking/ipv6/pools":           nil,
+	"networking/ipv6/ranges":          nil,
+	"networking/reserved/ips":         nil,
+	"object-storage/buckets":          nil,
+	"object-storage/buckets/{id}":     nil,
+	"object-storage/global-quotas":    nil,
+	"object-storage/quotas":           nil,
+	"regions":                         {"availability"},
+	"tags":                            nil,
+}
+
+// RequestEndpointTemplate converts a resolved endpoint into a template suitable for
+// use as a low-cardinality label by replacing IDs with "{id}", e.g. "linode/instances/{id}".
+// Both numeric IDs and the non-numeric IDs of known collections, such as region and type slugs,
+// image IDs, LKE versions and object storage bucket names, are replaced.
+func RequestEndpointTemplate(endpoint string) string {
+	endpoint, _, _ = strings.Cut(endpoint, "?")
+
+	segments := strings.Split(strings.Trim(endpoint, "/"), "/")
+
+	for i, segment := range segments {
+		if _, err := strconv.Atoi(segment); err == nil {
+			segments[i] = "{id}"
+			continue
+		}
+
+		static, ok := endpointNamedIDCollections[strings.Join(segments[:i], "/")]
+		if ok && !slices.Contains(static, segment) {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// RequestTracer starts spans for API calls. It mirrors the subset of the
+// OpenTelemetry tracing API used by TracingObserver so that an OpenTelemetry
+// tracer can be adapted with a small wrapper.
+type RequestTracer interface {
+	Start(ctx context.Context, spanName string) (context.Context, RequestSpan)
+}
+
+// RequestSpan is a span started by a RequestTracer.
+type RequestSpan interface {
+	SetAttribute(key string, value any)
+	RecordError(err error)
+	End()
+}
+
+// TracingObserver is a RequestObserver that emits a span for each API call,
+// with a child span for each attempt. Span names and attributes follow the
+// OpenTelemetry HTTP client semantic conventions.
+type TracingObserver struct {
+	Tracer RequestTracer
+}
+
+var _ RequestObserver = (*TracingObserver)(nil)
+
+// NewTracingObserver creates a new TracingObserver using the given tracer.
+func NewTracingObserver(tracer RequestTracer) *TracingObserver {
+	return &TracingObserver{Tracer: tracer}
+}
+
+func (o *TracingObserver) StartCall(ctx context.Context, event RequestEvent) (context.Context, func(RequestEvent)) {
+	return o.startSpan(ctx, event.Method+" "+event.EndpointTemplate, event)
+}
+
+func (o *TracingObserver) StartAttempt(ctx context.Context, event RequestEvent) (context.Context, func(RequestEvent)) {
+	return o.startSpan(ctx, event.Method+" "+event.EndpointTemplate+" attempt", event)
+}
+
+func (o *TracingObserver) startSpan(
+	ctx context.Context,
+	name string,
+	event RequestEvent,
+) (context.Context, func(RequestEvent)) {
+	ctx, span := o.Tracer.Start(ctx, name)
+
+	span.SetAttribute("http.request.method", event.Method)
+	span.SetAttribute("url.template", event.EndpointTemplate)
+	span.SetAttribute("url.path", event.Endpoint)
+
+	return ctx, func(result RequestEvent) {
+		// The first attempt is not a resend
+		span.SetAttribute("http.request.resend_count", max(result.Attempt-1, 0))
+
+		if result.StatusCode != 0 {
+			span.SetAttribute("http.response.status_code", result.StatusCode)
+		}
+
+		if result.Err != nil {
+			span.RecordError(result.Err)
+		}
+
+		span.End()
+	}
+}
+
+// MetricsRecorder records counters and histograms. It can be implemented on top of
+// Prometheus counter and histogram vectors keyed by the label names passed in.
+type MetricsRecorder interface {
+	IncCounter(name string, labels map[string]string)
+	ObserveHistogram(name string, labels map[string]string, value float64)
+}
+
+// MetricsObserver is a RequestObserver that records Prometheus-style request counters
+// and latency histograms labeled by "method", "endpoint", and "status".
+// The status label is the HTTP status code, or "error" if no response was received.
+type MetricsObserver struct {
+	Recorder MetricsRecorder
+}
+
+var _ RequestObserver = (*MetricsObserver)(nil)
+
+// NewMetricsObserver creates a new MetricsObserver using the given recorder.
+func NewMetricsObserver(recorder MetricsRecorder) *MetricsObserver {
+	return &MetricsObserver{Recorder: recorder}
+}
+
+func (o *MetricsObserver) StartCall(ctx context.Context, _ RequestEvent) (context.Context, func(RequestEvent)) {
+	return ctx, func(result RequestEvent) {
+		o.record(MetricRequestsTotal, MetricRequestDurationSeconds, result)
+	}
+}
+
+func (o *MetricsObserver) StartAttempt(ctx context.Context, _ RequestEvent) (context.Context, func(RequestEvent)) {
+	return ctx, func(result RequestEvent) {
+		o.record(MetricRequestAttemptsTotal, MetricRequestAttemptDurationSeconds, result)
+	}
+}
+
+func (o *MetricsObserver) record(counter, histogram string, event RequestEvent) {
+	status := "error"
+	if event.StatusCode != 0 {
+		status = strconv.Itoa(event.StatusCode)
+	}
+
+	labels := map[string]string{
+		"method":   event.Method,
+		"endpoint": event.EndpointTemplate,
+		"status":   status,
+	}
+
+	o.Recorder.IncCounter(counter, labels)
+	o.Recorder.ObserveHistogram(histogram, labels, event.Duration.Seconds())
+}
+
+// startRequestObservation notifies all observers that a call or attempt has started,
+// returning the derived context and a function to notify them of its outcome.
+func startRequestObservation(
+	ctx context.Context,
+	observers []RequestObserver,
+	event RequestEvent,
+	start func(RequestObserver, context.Context, RequestEvent) (context.Context, func(RequestEvent)),
+) (context.Context, func(attempt int, resp *http.Response, err error)) {
+	if len(observers) == 0 {
+		return ctx, func(int, *http.Response, error) {}
+	}
+
+	startTime := time.Now()
+	done := make([]func(RequestEvent), len(observers))
+
+	for i, observer := range observers {
+		ctx, done[i] = start(observer, ctx, event)
+	}
+
+	return ctx, func(attempt int, resp *http.Response, err error) {
+		result := event
+		result.Attempt = attempt
+		result.Duration = time.Since(startTime)
+		result.Err = err
+
+		if resp != nil {
+			result.StatusCode = resp.StatusCode
+		}
+
+		// Notify observers in reverse order so spans are closed inside-out
+		for i := len(done) - 1; i >= 0; i-- {
+			if done[i] != nil {
+				done[i](result)
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testSpan struct {
+	name       string
+	parent     *testSpan
+	attributes map[string]any
+	errs       []error
+	ended      bool
+}
+
+func (s *testSpan) SetAttribute(key string, value any) { s.attributes[key] = value }
+func (s *testSpan) RecordError(err error)              { s.errs = append(s.errs, err) }
+func (s *testSpan) End()                               { s.ended = true }
+
+type testSpanKey struct{}
+
+type testTracer struct {
+	mu    sync.Mutex
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, RequestSpan) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	parent, _ := ctx.Value(testSpanKey{}).(*testSpan)
+	span := &testSpan{name: name, parent: parent, attributes: make(map[string]any)}
+	t.spans = append(t.spans, span)
+
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+type testMetricsRecorder struct {
+	mu         sync.Mutex
+	counters   map[string]int
+	histograms map[string][]float64
+}
+
+func (r *testMetricsRecorder) IncCounter(name string, labels map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.counters[name+" "+labels["method"]+" "+labels["endpoint"]+" "+labels["status"]]++
+}
+
+func (r *testMetricsRecorder) ObserveHistogram(name string, labels map[string]string, value float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.histograms[name] = append(r.histograms[name], value)
+}
+
+func TestRequestEndpointTemplate(t *testing.T) {
+	require.Equal(t, "linode/instances/{id}/disks/{id}", RequestEndpointTemplate("linode/instances/123/disks/456"))
+	require.Equal(t, "regions/{id}", RequestEndpointTemplate("/regions/us-east"))
+	require.Equal(t, "regions/availability", RequestEndpointTemplate("regions/availability"))
+	require.Equal(t, "regions/{id}/availability", RequestEndpointTemplate("regions/us-east/availability"))
+	require.Equal(t, "images/{id}", RequestEndpointTemplate(formatAPIPath("images/%s", "private/123")))
+	require.Equal(t, "images/upload", RequestEndpointTemplate("images/upload"))
+	require.Equal(t, "lke/tiers/{id}/versions/{id}", RequestEndpointTemplate("lke/tiers/standard/versions/1.31"))
+	require.Equal(t, "object-storage/buckets/{id}/{id}/object-list",
+		RequestEndpointTemplate("object-storage/buckets/us-east-1/my-bucket/object-list"))
+	require.Equal(t, "networking/ips/assign", RequestEndpointTemplate("networking/ips/assign"))
+	require.Equal(t, "linode/types/{id}", RequestEndpointTemplate("linode/types/g6-standard-2"))
+	require.Equal(t, "account/events", RequestEndpointTemplate("account/events?page=2"))
+}
+
+func TestDoRequest_RequestObservers(t *testing.T) {
+	var calls atomic.Int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	tracer := &testTracer{}
+	recorder := &testMetricsRecorder{counters: make(map[string]int), histograms: make(map[string][]float64)}
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+	client.SetRetryMaxWaitTime(time.Millisecond)
+	client.AddRequestObserver(NewTracingObserver(tracer))
+	client.AddRequestObserver(NewMetricsObserver(recorder))
+
+	require.NoError(t, client.doRequest(context.Background(), http.MethodGet, "linode/instances/123", requestParams{}, nil))
+
+	// One call span with a child span for each attempt
+	require.Len(t, tracer.spans, 3)
+
+	call := tracer.spans[0]
+	require.Equal(t, "GET linode/instances/{id}", call.name)
+	require.Nil(t, call.parent)
+	require.True(t, call.ended)
+	require.Equal(t, 1, call.attributes["http.request.resend_count"])
+	require.Equal(t, http.StatusOK, call.attributes["http.response.status_code"])
+	require.Empty(t, call.errs)
+
+	for _, attempt := range tracer.spans[1:] {
+		require.Same(t, call, attempt.parent)
+		require.True(t, attempt.ended)
+	}
+
+	require.Equal(t, http.StatusTooManyRequests, tracer.spans[1].attributes["http.response.status_code"])
+	require.Len(t, tracer.spans[1].errs, 1)
+
+	require.Equal(t, map[string]int{
+		MetricRequestsTotal + " GET linode/instances/{id} 200":        1,
+		MetricRequestAttemptsTotal + " GET linode/instances/{id} 429": 1,
+		MetricRequestAttemptsTotal + " GET linode/instances/{id} 200": 1,
+	}, recorder.counters)
+	require.Len(t, recorder.histograms[MetricRequestDurationSeconds], 1)
+	require.Len(t, recorder.histograms[MetricRequestAttemptDurationSeconds], 2)
+}
@@ -0,0 +1,165 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Operation is a handle to a long-running operation started by a mutating call,
+// such as BootInstanceOp or ResizeVolumeOp. It tracks the event of the operation.
+//
+//	op, err := client.ResizeInstanceOp(ctx, linodeID, linodego.InstanceResizeOptions{Type: "g6-standard-2"})
+//	if err != nil {
+//		return err
+//	}
+//	event, err := op.Wait(ctx)
+type Operation struct {
+	poller *EventPoller
+
+	// waitMu serializes calls to Wait, as the poller is not safe for concurrent use
+	waitMu sync.Mutex
+	done   bool
+	err    error
+
+	// mu guards the fields below, which are read by Event and Progress during a wait
+	mu       sync.Mutex
+	event    *Event
+	progress Progress
+}
+
+// startOperation creates an event poller for the given entity and action, then runs the action.
+// The poller is created first so the event of the action is not mistaken for an earlier one.
+func (c *Client) startOperation(
+	ctx context.Context, entityType EntityType, entityID any, action EventAction, run func() error,
+) (*Operation, error) {
+	poller, err := c.NewEventPoller(ctx, entityID, entityType, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := run(); err != nil {
+		return nil, err
+	}
+
+	return &Operation{poller: poller}, nil
+}
+
+// Wait waits for the event of the operation to finish, returning the finished event.
+// If the event failed, both the failed event and an error are returned.
+// Once the event has finished or failed, Wait returns its result immediately;
+// otherwise a wait that was interrupted, e.g. by ctx being canceled, can be resumed by calling Wait again.
+// The event is polled using the PollOptions of ctx, if any (see WithPollOptions),
+// and the progress of the operation is reported to the ProgressFunc of ctx, if any (see WithProgress).
+func (o *Operation) Wait(ctx context.Context) (*Event, error) {
+	o.waitMu.Lock()
+	defer o.waitMu.Unlock()
+
+	if o.done {
+		return o.Event(), o.err
+	}
+
+	// The timeout of the PollOptions of ctx applies to the whole wait
+	ctx, cancel := withPollTimeout(ctx, &o.poller.client)
+	defer cancel()
+
+	fn, _ := ctx.Value(progressContextKey{}).(ProgressFunc)
+	progress := newProgressReporter(WithProgress(ctx, func(p Progress) {
+		o.mu.Lock()
+		o.progress = p
+		o.mu.Unlock()
+
+		if fn != nil {
+			fn(p)
+		}
+	}))
+
+	event := o.Event()
+	if event == nil {
+		var err error
+
+		event, err = o.poller.WaitForLatestUnknownEvent(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wait for event: %w", err)
+		}
+
+		o.setEvent(event)
+		progress.reportEvent(event)
+	}
+
+	if o.finish(event) {
+		return event, o.err
+	}
+
+	eventID := event.ID
+
+	event, err := poll(ctx, &o.poller.client,
+		func(ctx context.Context) (*Event, bool, error) {
+			next, err := o.poller.client.GetEvent(ctx, eventID)
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to get event: %w", err)
+			}
+
+			o.setEvent(next)
+			progress.reportEvent(next)
+
+			return next, next.Status == EventFinished || next.Status == EventFailed, nil
+		},
+		func(err error) error {
+			return fmt.Errorf("failed to wait for event finished: %w", err)
+		},
+	)
+	if err != nil {
+		return o.Event(), err
+	}
+
+	o.finish(event)
+
+	return event, o.err
+}
+
+// finish records the result of the operation if its event has finished or failed,
+// returning whether it has. The caller must hold waitMu.
+func (o *Operation) finish(event *Event) bool {
+	switch event.Status {
+	case EventFinished:
+		o.done = true
+	case EventFailed:
+		o.done = true
+		o.err = fmt.Errorf("event %d has failed", event.ID)
+	}
+
+	return o.done
+}
+
+// Event returns the most recently seen state of the operation's event,
+// or nil if the event has not been seen yet.
+func (o *Operation) Event() *Event {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.event
+}
+
+// Progress returns the most recently reported progress of the operation.
+func (o *Operation) Progress() Progress {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.progress
+}
+
+// Cancel cancels the operation.
+// None of the actions that currently return an Operation can be canceled through the API,
+// so an error matching errors.ErrUnsupported is returned for them.
+func (o *Operation) Cancel(_ context.Context) error {
+	return fmt.Errorf("%s operation cannot be canceled: %w", o.poller.Action, errors.ErrUnsupported)
+}
+
+func (o *Operation) setEvent(event *Event) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.event = event
+}
@@ -0,0 +1,221 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newOperationTestServer serves a single earlier resize event until the instance is resized,
+// then a new resize event whose status is returned by status for each GET of the event.
+func newOperationTestServer(t *testing.T, status func(get int32) (EventStatus, int)) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var (
+		resized atomic.Bool
+		gets    atomic.Int32
+	)
+
+	event := func(id int, status EventStatus, percent int) string {
+		return fmt.Sprintf(
+			`{"id": %d, "action": "linode_resize", "status": %q, "percent_complete": %d, "entity": {"id": 123, "type": "linode"}}`,
+			id, status, percent,
+		)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/linode/instances/123/resize":
+			require.Equal(t, http.MethodPost, r.Method)
+			resized.Store(true)
+			_, _ = w.Write([]byte("{}"))
+		case "/v4/account/events":
+			if !resized.Load() {
+				_, _ = fmt.Fprintf(w, `{"data": [%s], "page": 1, "pages": 1, "results": 1}`, event(1, EventFinished, 100))
+				return
+			}
+
+			_, _ = fmt.Fprintf(w, `{"data": [%s, %s], "page": 1, "pages": 1, "results": 2}`,
+				event(2, EventStarted, 0), event(1, EventFinished, 100))
+		case "/v4/account/events/2":
+			status, percent := status(gets.Add(1))
+			_, _ = w.Write([]byte(event(2, status, percent)))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+
+	return server, &gets
+}
+
+func TestClient_ResizeInstanceOp(t *testing.T) {
+	server, gets := newOperationTestServer(t, func(get int32) (EventStatus, int) {
+		if get < 3 {
+			return EventStarted, 40
+		}
+
+		return EventFinished, 100
+	})
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(time.Millisecond)
+
+	op, err := client.ResizeInstanceOp(context.Background(), 123, InstanceResizeOptions{Type: "g6-standard-2"})
+	require.NoError(t, err)
+	require.Nil(t, op.Event())
+
+	var reports []Progress
+
+	ctx := WithProgress(context.Background(), func(progress Progress) {
+		reports = append(reports, progress)
+	})
+
+	event, err := op.Wait(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, event.ID)
+	require.Equal(t, EventFinished, event.Status)
+
+	require.Equal(t, event, op.Event())
+	require.Equal(t, 100, op.Progress().PercentComplete)
+	require.Len(t, reports, 3)
+
+	// Waiting on a finished operation returns its result without polling
+	event, err = op.Wait(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, event.ID)
+	require.Equal(t, int32(3), gets.Load())
+
+	require.ErrorIs(t, op.Cancel(context.Background()), errors.ErrUnsupported)
+}
+
+func TestOperation_WaitFailedAndResumed(t *testing.T) {
+	server, _ := newOperationTestServer(t, func(get int32) (EventStatus, int) {
+		if get < 2 {
+			return EventStarted, 40
+		}
+
+		return EventFailed, 40
+	})
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(time.Millisecond)
+
+	op, err := client.ResizeInstanceOp(context.Background(), 123, InstanceResizeOptions{Type: "g6-standard-2"})
+	require.NoError(t, err)
+
+	// Stop waiting once the event has been seen, then resume the wait
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, err = op.Wait(WithProgress(ctx, func(Progress) {
+		cancel()
+	}))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, EventStarted, op.Event().Status)
+
+	event, err := op.Wait(context.Background())
+	require.Error(t, err)
+	require.Equal(t, 2, event.ID)
+	require.Equal(t, EventFailed, event.Status)
+	require.Equal(t, EventFailed, op.Event().Status)
+}
+
+func TestOperation_WaitPollOptions(t *testing.T) {
+	server, gets := newOperationTestServer(t, func(get int32) (EventStatus, int) {
+		if get < 3 {
+			return EventStarted, 40
+		}
+
+		return EventFinished, 100
+	})
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(time.Hour)
+
+	op, err := client.ResizeInstanceOp(context.Background(), 123, InstanceResizeOptions{Type: "g6-standard-2"})
+	require.NoError(t, err)
+
+	var attempts []PollAttempt
+
+	// The wait is polled using the PollOptions of ctx rather than the client's poll delay
+	ctx := WithPollOptions(context.Background(), PollOptions{
+		InitialInterval: time.Millisecond,
+		Timeout:         5 * time.Second,
+		OnPoll: func(attempt PollAttempt) {
+			attempts = append(attempts, attempt)
+		},
+	})
+
+	event, err := op.Wait(ctx)
+	require.NoError(t, err)
+	require.Equal(t, EventFinished, event.Status)
+	require.Equal(t, int32(3), gets.Load())
+
+	// The event is found on the first poll, then polled until it finishes
+	require.Len(t, attempts, 4)
+	require.True(t, attempts[3].Done)
+}
+
+func TestClient_CloneInstanceDiskOp(t *testing.T) {
+	var cloned atomic.Bool
+
+	event := func(status EventStatus, percent int) string {
+		return fmt.Sprintf(
+			`{"id": 5, "action": "disk_duplicate", "status": %q, "percent_complete": %d, "entity": {"id": 123, "type": "linode"}}`,
+			status, percent,
+		)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/linode/instances/123/disks/456/clone":
+			require.Equal(t, http.MethodPost, r.Method)
+			cloned.Store(true)
+			_, _ = w.Write([]byte(`{"id": 789, "label": "Clone of disk"}`))
+		case "/v4/account/events":
+			if !cloned.Load() {
+				_, _ = w.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+				return
+			}
+
+			_, _ = fmt.Fprintf(w, `{"data": [%s], "page": 1, "pages": 1, "results": 1}`, event(EventStarted, 20))
+		case "/v4/account/events/5":
+			_, _ = w.Write([]byte(event(EventFinished, 100)))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(time.Millisecond)
+
+	disk, op, err := client.CloneInstanceDiskOp(context.Background(), 123, 456)
+	require.NoError(t, err)
+	require.Equal(t, 789, disk.ID)
+
+	var reports []Progress
+
+	finished, err := op.Wait(WithProgress(context.Background(), func(progress Progress) {
+		reports = append(reports, progress)
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 5, finished.ID)
+
+	require.Len(t, reports, 2)
+	require.Equal(t, 20, reports[0].PercentComplete)
+	require.Equal(t, 100, reports[1].PercentComplete)
+}
@@ -32,6 +32,16 @@ type ListOptions struct {
 	// calls. QueryParams should be an instance of a struct containing fields with
 	// the `query` tag.
 	QueryParams any
+
+	// PageConcurrency is the maximum number of pages to fetch concurrently
+	// once the total number of pages is known. This overrides the client's
+	// page concurrency if set. Values <= 1 fetch pages sequentially.
+	PageConcurrency int `json:"-"`
+
+	// FilterSchema optionally restricts the fields and operators that may be
+	// used in Filter. Filters that do not match the schema are rejected
+	// before the request is sent.
+	FilterSchema FilterSchema `json:"-"`
 }
 
 // NewListOptions simplified construction of ListOptions using only
@@ -87,6 +97,12 @@ func createListOptionsToRequestMutator(opts *ListOptions) func(*http.Request) er
 
 		// Apply filters as headers
 		if len(opts.Filter) > 0 {
+			if opts.FilterSchema != nil {
+				if err := opts.FilterSchema.ValidateString(opts.Filter); err != nil {
+					return fmt.Errorf("invalid filter: %w", err)
+				}
+			}
+
 			req.Header.Set("X-Filter", opts.Filter)
 		}
 
@@ -1,6 +1,9 @@
 package linodego
 
-import "context"
+import (
+	"context"
+	"iter"
+)
 
 // PlacementGroupType is an enum that determines the affinity policy
 // for Linodes in a placement group.
@@ -91,6 +94,20 @@ func (c *Client) ListPlacementGroups(
 	)
 }
 
+// IterPlacementGroups returns an iterator over the results of ListPlacementGroups,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterPlacementGroups(
+	ctx context.Context,
+	options *ListOptions,
+) iter.Seq2[PlacementGroup, error] {
+	return getPaginatedIter[PlacementGroup](
+		ctx,
+		c,
+		"placement/groups",
+		options,
+	)
+}
+
 // GetPlacementGroup gets a placement group with the specified ID.
 func (c *Client) GetPlacementGroup(
 	ctx context.Context,
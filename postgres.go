@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -631,6 +632,12 @@ func (c *Client) ListPostgresDatabases(ctx context.Context, opts *ListOptions) (
 	return getPaginatedResults[PostgresDatabase](ctx, c, "databases/postgresql/instances", opts)
 }
 
+// IterPostgresDatabases returns an iterator over the results of ListPostgresDatabases,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterPostgresDatabases(ctx context.Context, opts *ListOptions) iter.Seq2[PostgresDatabase, error] {
+	return getPaginatedIter[PostgresDatabase](ctx, c, "databases/postgresql/instances", opts)
+}
+
 // GetPostgresDatabase returns a single Postgres Database matching the id
 func (c *Client) GetPostgresDatabase(ctx context.Context, databaseID int) (*PostgresDatabase, error) {
 	e := formatAPIPath("databases/postgresql/instances/%d", databaseID)
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -54,6 +55,12 @@ func (c *Client) ListPrefixLists(ctx context.Context, opts *ListOptions) ([]Pref
 	return getPaginatedResults[PrefixList](ctx, c, "networking/prefixlists", opts)
 }
 
+// IterPrefixLists returns an iterator over the results of ListPrefixLists,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterPrefixLists(ctx context.Context, opts *ListOptions) iter.Seq2[PrefixList, error] {
+	return getPaginatedIter[PrefixList](ctx, c, "networking/prefixlists", opts)
+}
+
 // GetPrefixList fetches a single Prefix List by its ID.
 func (c *Client) GetPrefixList(ctx context.Context, id int) (*PrefixList, error) {
 	endpoint := formatAPIPath("networking/prefixlists/%d", id)
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -66,6 +67,12 @@ func (c *Client) ListProfileApps(ctx context.Context, opts *ListOptions) ([]Prof
 	return getPaginatedResults[ProfileApp](ctx, c, "profile/apps", opts)
 }
 
+// IterProfileApps returns an iterator over the results of ListProfileApps,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterProfileApps(ctx context.Context, opts *ListOptions) iter.Seq2[ProfileApp, error] {
+	return getPaginatedIter[ProfileApp](ctx, c, "profile/apps", opts)
+}
+
 // DeleteProfileApp revokes the given ProfileApp's access to the account
 func (c *Client) DeleteProfileApp(ctx context.Context, appID int) error {
 	e := formatAPIPath("profile/apps/%d", appID)
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -65,6 +66,12 @@ func (c *Client) ListProfileDevices(ctx context.Context, opts *ListOptions) ([]P
 	return getPaginatedResults[ProfileDevice](ctx, c, "profile/devices", opts)
 }
 
+// IterProfileDevices returns an iterator over the results of ListProfileDevices,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterProfileDevices(ctx context.Context, opts *ListOptions) iter.Seq2[ProfileDevice, error] {
+	return getPaginatedIter[ProfileDevice](ctx, c, "profile/devices", opts)
+}
+
 // DeleteProfileDevice revokes the given ProfileDevice's status as a trusted device
 func (c *Client) DeleteProfileDevice(ctx context.Context, deviceID int) error {
 	e := formatAPIPath("profile/devices/%d", deviceID)
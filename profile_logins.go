@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -49,3 +50,9 @@ func (c *Client) GetProfileLogin(ctx context.Context, id int) (*ProfileLogin, er
 func (c *Client) ListProfileLogins(ctx context.Context, opts *ListOptions) ([]ProfileLogin, error) {
 	return getPaginatedResults[ProfileLogin](ctx, c, "profile/logins", opts)
 }
+
+// IterProfileLogins returns an iterator over the results of ListProfileLogins,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterProfileLogins(ctx context.Context, opts *ListOptions) iter.Seq2[ProfileLogin, error] {
+	return getPaginatedIter[ProfileLogin](ctx, c, "profile/logins", opts)
+}
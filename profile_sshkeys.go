@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -67,6 +68,12 @@ func (c *Client) ListSSHKeys(ctx context.Context, opts *ListOptions) ([]SSHKey,
 	return getPaginatedResults[SSHKey](ctx, c, "profile/sshkeys", opts)
 }
 
+// IterSSHKeys returns an iterator over the results of ListSSHKeys,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterSSHKeys(ctx context.Context, opts *ListOptions) iter.Seq2[SSHKey, error] {
+	return getPaginatedIter[SSHKey](ctx, c, "profile/sshkeys", opts)
+}
+
 // GetSSHKey gets the sshkey with the provided ID
 func (c *Client) GetSSHKey(ctx context.Context, keyID int) (*SSHKey, error) {
 	e := formatAPIPath("profile/sshkeys/%d", keyID)
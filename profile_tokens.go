@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -91,6 +92,12 @@ func (c *Client) ListTokens(ctx context.Context, opts *ListOptions) ([]Token, er
 	return getPaginatedResults[Token](ctx, c, "profile/tokens", opts)
 }
 
+// IterTokens returns an iterator over the results of ListTokens,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterTokens(ctx context.Context, opts *ListOptions) iter.Seq2[Token, error] {
+	return getPaginatedIter[Token](ctx, c, "profile/tokens", opts)
+}
+
 // GetToken gets the token with the provided ID
 func (c *Client) GetToken(ctx context.Context, tokenID int) (*Token, error) {
 	e := formatAPIPath("profile/tokens/%d", tokenID)
@@ -0,0 +1,146 @@
+package linodego
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Progress is the progress of a long-running operation, such as a migration, resize,
+// disk clone or image upload.
+type Progress struct {
+	// PercentComplete is the estimated percentage of the operation that is complete.
+	PercentComplete int
+
+	// TimeRemaining is the estimated time until the operation completes, if known.
+	TimeRemaining *time.Duration
+
+	// Rate is the rate of completion reported by the API, e.g. for migrations and resizes.
+	Rate string
+
+	// Event is the event the progress was reported from, if any.
+	Event *Event
+
+	// BytesTransferred is the number of bytes sent by an upload.
+	BytesTransferred int64
+
+	// BytesTotal is the total number of bytes of an upload, or -1 if it is not known.
+	BytesTotal int64
+}
+
+// ProgressFunc is called with the progress of an operation each time it changes.
+type ProgressFunc func(Progress)
+
+type progressContextKey struct{}
+
+// WithProgress returns a copy of ctx that reports the progress of operations waited for using it,
+// such as WaitForEventFinished, EventPoller.WaitForFinished and UploadImageToURL.
+// fn is called each time the progress changes.
+//
+// To report the progress of a disk clone, wait for it using WaitForInstanceDiskClone:
+//
+//	minStart := time.Now()
+//	disk, err := client.CloneInstanceDisk(ctx, linodeID, diskID)
+//	event, err := client.WaitForInstanceDiskClone(linodego.WithProgress(ctx, func(p linodego.Progress) {
+//		fmt.Printf("%d%% complete\n", p.PercentComplete)
+//	}), linodeID, minStart)
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressContextKey{}, fn)
+}
+
+// progressReporter reports the progress of an operation when it changes.
+type progressReporter struct {
+	fn   ProgressFunc
+	last *Progress
+}
+
+// newProgressReporter returns a reporter using the ProgressFunc of ctx, or nil if there is none.
+func newProgressReporter(ctx context.Context) *progressReporter {
+	fn, ok := ctx.Value(progressContextKey{}).(ProgressFunc)
+	if !ok || fn == nil {
+		return nil
+	}
+
+	return &progressReporter{fn: fn}
+}
+
+func (r *progressReporter) report(progress Progress) {
+	if r == nil || (r.last != nil && r.last.equal(progress)) {
+		return
+	}
+
+	r.last = &progress
+	r.fn(progress)
+}
+
+// reportEvent reports the progress of the given event.
+func (r *progressReporter) reportEvent(event *Event) {
+	if r == nil {
+		return
+	}
+
+	progress := Progress{
+		PercentComplete: event.PercentComplete,
+		Event:           event,
+	}
+
+	if event.Status == EventFinished {
+		progress.PercentComplete = 100
+	}
+
+	if event.TimeRemaining != nil {
+		timeRemaining := time.Duration(*event.TimeRemaining) * time.Second
+		progress.TimeRemaining = &timeRemaining
+	}
+
+	if event.Rate != nil {
+		progress.Rate = *event.Rate
+	}
+
+	r.report(progress)
+}
+
+func (p Progress) equal(other Progress) bool {
+	sameTimeRemaining := (p.TimeRemaining == nil) == (other.TimeRemaining == nil) &&
+		(p.TimeRemaining == nil || *p.TimeRemaining == *other.TimeRemaining)
+
+	return sameTimeRemaining &&
+		p.PercentComplete == other.PercentComplete &&
+		p.Rate == other.Rate &&
+		p.BytesTransferred == other.BytesTransferred &&
+		p.BytesTotal == other.BytesTotal
+}
+
+// progressReader reports the progress of an upload as it is read.
+// If the size of the upload is known, progress is reported each time the percentage changes,
+// otherwise it is reported on each read.
+type progressReader struct {
+	io.Reader
+
+	reporter *progressReporter
+	read     int64
+	total    int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.read += int64(n)
+
+	if n == 0 {
+		return n, err
+	}
+
+	progress := Progress{BytesTransferred: r.read, BytesTotal: r.total}
+
+	if r.total > 0 {
+		progress.PercentComplete = int(r.read * 100 / r.total)
+
+		if last := r.reporter.last; last != nil && last.PercentComplete == progress.PercentComplete {
+			return n, err
+		}
+	}
+
+	r.reporter.report(progress)
+
+	return n, err
+}
@@ -0,0 +1,211 @@
+package linodego
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventPoller_WaitForFinishedProgress(t *testing.T) {
+	var gets atomic.Int32
+
+	event := func(status EventStatus, percent int, timeRemaining string) string {
+		return fmt.Sprintf(
+			`{"id": 1, "action": "disk_duplicate", "status": %q, "percent_complete": %d, "time_remaining": %s, "rate": "10 MB/s", `+
+				`"entity": {"id": 123, "type": "linode"}}`,
+			status, percent, timeRemaining,
+		)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/v4/account/events" {
+			_, _ = fmt.Fprintf(w, `{"data": [%s], "page": 1, "pages": 1, "results": 1}`, event(EventStarted, 10, "null"))
+			return
+		}
+
+		switch gets.Add(1) {
+		case 1:
+			_, _ = w.Write([]byte(event(EventStarted, 10, "null")))
+		case 2, 3:
+			_, _ = w.Write([]byte(event(EventStarted, 50, `"00:01:30"`)))
+		default:
+			_, _ = w.Write([]byte(event(EventFinished, 90, "null")))
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(time.Millisecond)
+
+	poller, err := client.NewEventPollerWithoutEntity(EntityLinode, ActionDiskDuplicate)
+	require.NoError(t, err)
+
+	poller.EntityID = 123
+
+	var reports []Progress
+
+	ctx := WithProgress(context.Background(), func(progress Progress) {
+		reports = append(reports, progress)
+	})
+
+	_, err = poller.WaitForFinished(ctx)
+	require.NoError(t, err)
+
+	require.Len(t, reports, 3)
+	require.Equal(t, 10, reports[0].PercentComplete)
+	require.Nil(t, reports[0].TimeRemaining)
+	require.Equal(t, "10 MB/s", reports[0].Rate)
+
+	require.Equal(t, 50, reports[1].PercentComplete)
+	require.Equal(t, 90*time.Second, *reports[1].TimeRemaining)
+
+	require.Equal(t, 100, reports[2].PercentComplete)
+	require.Equal(t, EventFinished, reports[2].Event.Status)
+}
+
+func TestClient_UploadImageToURLProgress(t *testing.T) {
+	var received atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body bytes.Buffer
+		_, _ = body.ReadFrom(r.Body)
+		received.Store(int64(body.Len()))
+
+		require.Equal(t, int64(body.Len()), r.ContentLength)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+
+	var reports []Progress
+
+	ctx := WithProgress(context.Background(), func(progress Progress) {
+		reports = append(reports, progress)
+	})
+
+	image := bytes.NewReader(make([]byte, 1<<20))
+	require.NoError(t, client.UploadImageToURL(ctx, server.URL, image))
+	require.Equal(t, int64(1<<20), received.Load())
+
+	require.NotEmpty(t, reports)
+
+	last := reports[len(reports)-1]
+	require.Equal(t, 100, last.PercentComplete)
+	require.Equal(t, int64(1<<20), last.BytesTransferred)
+	require.Equal(t, int64(1<<20), last.BytesTotal)
+
+	for i := 1; i < len(reports); i++ {
+		require.Greater(t, reports[i].PercentComplete, reports[i-1].PercentComplete)
+	}
+}
+
+func TestClient_WaitForEventFinishedReportsTrackedEvent(t *testing.T) {
+	var requests atomic.Int32
+
+	event := func(id int, status EventStatus, percent int) string {
+		return fmt.Sprintf(
+			`{"id": %d, "action": "linode_resize", "status": %q, "percent_complete": %d, "entity": {"id": 123, "type": "linode"}}`,
+			id, status, percent,
+		)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		// An earlier event for the same action is listed after the latest one
+		if requests.Add(1) == 1 {
+			_, _ = fmt.Fprintf(w, `{"data": [%s, %s], "page": 1, "pages": 1, "results": 2}`,
+				event(3, EventStarted, 30), event(2, EventFinished, 100))
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"data": [%s], "page": 1, "pages": 1, "results": 1}`, event(3, EventFinished, 100))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(time.Millisecond)
+
+	var reports []Progress
+
+	ctx := WithProgress(context.Background(), func(progress Progress) {
+		reports = append(reports, progress)
+	})
+
+	finished, err := client.WaitForEventFinished(ctx, 123, EntityLinode, ActionLinodeResize, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 3, finished.ID)
+
+	require.Len(t, reports, 2)
+
+	for _, report := range reports {
+		require.Equal(t, 3, report.Event.ID)
+	}
+
+	require.Equal(t, 30, reports[0].PercentComplete)
+	require.Equal(t, 100, reports[1].PercentComplete)
+}
+
+func TestClient_WaitForInstanceDiskCloneProgress(t *testing.T) {
+	var requests atomic.Int32
+
+	event := func(status EventStatus, percent int) string {
+		return fmt.Sprintf(
+			`{"id": 5, "action": "disk_duplicate", "status": %q, "percent_complete": %d, "entity": {"id": 123, "type": "linode"}}`,
+			status, percent,
+		)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/linode/instances/123/disks/456/clone":
+			require.Equal(t, http.MethodPost, r.Method)
+			_, _ = w.Write([]byte(`{"id": 789, "label": "Clone of disk"}`))
+		case "/v4/account/events":
+			require.Contains(t, r.Header.Get("X-Filter"), `"action":"disk_duplicate"`)
+
+			status, percent := EventStarted, 20*int(requests.Add(1))
+			if percent >= 60 {
+				status, percent = EventFinished, 100
+			}
+
+			_, _ = fmt.Fprintf(w, `{"data": [%s], "page": 1, "pages": 1, "results": 1}`, event(status, percent))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(time.Millisecond)
+
+	minStart := time.Now()
+
+	disk, err := client.CloneInstanceDisk(context.Background(), 123, 456)
+	require.NoError(t, err)
+	require.Equal(t, 789, disk.ID)
+
+	var reports []Progress
+
+	finished, err := client.WaitForInstanceDiskClone(WithProgress(context.Background(), func(progress Progress) {
+		reports = append(reports, progress)
+	}), 123, minStart)
+	require.NoError(t, err)
+	require.Equal(t, 5, finished.ID)
+
+	require.Len(t, reports, 3)
+	require.Equal(t, 20, reports[0].PercentComplete)
+	require.Equal(t, 40, reports[1].PercentComplete)
+	require.Equal(t, 100, reports[2].PercentComplete)
+}
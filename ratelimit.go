@@ -0,0 +1,275 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	RateLimitLimitHeaderName     = "X-RateLimit-Limit"
+	RateLimitRemainingHeaderName = "X-RateLimit-Remaining"
+	RateLimitResetHeaderName     = "X-RateLimit-Reset"
+
+	// DefaultRateLimitWindow is the window over which the limit reported
+	// by the API is assumed to replenish.
+	DefaultRateLimitWindow = time.Minute
+)
+
+// RateLimitFamilyFunc maps a request to the name of the bucket used to rate limit it.
+type RateLimitFamilyFunc func(req *http.Request) string
+
+// RateLimiterOptions configures a RateLimiter.
+type RateLimiterOptions struct {
+	// Limit is the initial number of requests allowed per Window for each family.
+	// Once the API reports a limit for a family, that limit is used instead.
+	// If 0, requests are not throttled until the API reports a limit.
+	Limit int
+
+	// Window is the duration over which Limit replenishes.
+	// Defaults to DefaultRateLimitWindow.
+	Window time.Duration
+
+	// Family maps a request to the bucket used to rate limit it.
+	// Defaults to DefaultRateLimitFamily.
+	Family RateLimitFamilyFunc
+}
+
+// RateLimiter is a client-side token bucket rate limiter that throttles outgoing requests
+// based on the rate limit headers returned by the Linode API.
+// Requests are grouped into families, each with its own bucket.
+// A RateLimiter is safe for concurrent use and may be shared between clients.
+type RateLimiter struct {
+	options RateLimiterOptions
+
+	mu      sync.Mutex
+	buckets map[string]*rateLimitBucket
+}
+
+type rateLimitBucket struct {
+	capacity float64
+	tokens   float64
+	// rate is the number of tokens replenished per second
+	rate float64
+	// last is the time tokens were last replenished; it may be in the
+	// future if the bucket is blocked until the API's reset time.
+	last  time.Time
+	reset time.Time
+}
+
+// NewRateLimiter creates a new RateLimiter with the given options.
+func NewRateLimiter(options RateLimiterOptions) *RateLimiter {
+	if options.Window <= 0 {
+		options.Window = DefaultRateLimitWindow
+	}
+
+	if options.Family == nil {
+		options.Family = DefaultRateLimitFamily
+	}
+
+	return &RateLimiter{
+		options: options,
+		buckets: make(map[string]*rateLimitBucket),
+	}
+}
+
+// rateLimitVersionSegment matches the API version segment of a request path, e.g. "v4" or "v4beta".
+var rateLimitVersionSegment = regexp.MustCompile(`^v\d+(beta)?$`)
+
+// DefaultRateLimitFamily groups requests by method and the leading non-numeric
+// segments of the endpoint path, e.g. "POST linode/instances" or "GET volumes".
+// The endpoint path starts after the API version segment, if any,
+// so any path prefix of the base URL is skipped along with it.
+func DefaultRateLimitFamily(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+
+	// Skip the API version segment and anything before it
+	for i, segment := range segments {
+		if rateLimitVersionSegment.MatchString(segment) {
+			segments = segments[i+1:]
+			break
+		}
+	}
+
+	family := make([]string, 0, 2)
+
+	for _, segment := range segments {
+		if len(family) == 2 || segment == "" {
+			break
+		}
+
+		if _, err := strconv.Atoi(segment); err == nil {
+			break
+		}
+
+		family = append(family, segment)
+	}
+
+	return req.Method + " " + strings.Join(family, "/")
+}
+
+// Wait blocks until the given request is allowed to be sent or ctx is done.
+// If ctx is done first, the request's token is returned to its bucket.
+func (r *RateLimiter) Wait(ctx context.Context, req *http.Request) error {
+	family := r.options.Family(req)
+
+	wait := r.reserve(family, time.Now())
+	if wait <= 0 {
+		return nil
+	}
+
+	if err := sleepContext(ctx, wait); err != nil {
+		r.release(family)
+		return err
+	}
+
+	return nil
+}
+
+// Update adjusts the bucket of the request associated with the given response
+// using the rate limit headers returned by the API.
+func (r *RateLimiter) Update(resp *http.Response) {
+	if resp == nil || resp.Request == nil {
+		return
+	}
+
+	now := time.Now()
+
+	limit, hasLimit := parseRateLimitHeader(resp.Header, RateLimitLimitHeaderName)
+	remaining, hasRemaining := parseRateLimitHeader(resp.Header, RateLimitRemainingHeaderName)
+	resetUnix, hasReset := parseRateLimitHeader(resp.Header, RateLimitResetHeaderName)
+
+	retryAfter, _ := RespectRetryAfter(resp)
+	tooManyRequests := resp.StatusCode == http.StatusTooManyRequests
+
+	if !hasLimit && !hasRemaining && !tooManyRequests {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b := r.bucket(r.options.Family(resp.Request), now)
+	b.refill(now)
+
+	if hasLimit && limit > 0 {
+		b.capacity = float64(limit)
+		b.rate = float64(limit) / r.options.Window.Seconds()
+	}
+
+	var reset time.Time
+	if hasReset {
+		reset = time.Unix(int64(resetUnix), 0)
+	}
+
+	if hasRemaining {
+		// A new reset time indicates a new window, in which case the API's
+		// count is authoritative. Otherwise, in-flight requests may have already
+		// consumed tokens, so only ever lower the count.
+		if !reset.IsZero() && !reset.Equal(b.reset) {
+			b.tokens = float64(remaining)
+		} else {
+			b.tokens = min(b.tokens, float64(remaining))
+		}
+	}
+
+	if !reset.IsZero() {
+		b.reset = reset
+	}
+
+	blockUntil := time.Time{}
+
+	if hasRemaining && remaining <= 0 && reset.After(now) {
+		blockUntil = reset
+	}
+
+	if tooManyRequests {
+		if retryAfter > 0 {
+			blockUntil = now.Add(retryAfter)
+		} else if blockUntil.IsZero() && reset.After(now) {
+			blockUntil = reset
+		}
+	}
+
+	if blockUntil.After(b.last) {
+		b.tokens = 0
+		b.last = blockUntil
+	}
+}
+
+func (r *RateLimiter) reserve(family string, now time.Time) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b := r.bucket(family, now)
+
+	// The limit for this family is not yet known, so only a block
+	// following a 429 response applies
+	if b.capacity <= 0 {
+		return max(b.last.Sub(now), 0)
+	}
+
+	b.refill(now)
+
+	b.tokens--
+
+	wait := max(b.last.Sub(now), 0)
+
+	if b.tokens < 0 && b.rate > 0 {
+		wait += time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+
+	return wait
+}
+
+// release returns a token reserved for a request that was not sent.
+func (r *RateLimiter) release(family string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if b, ok := r.buckets[family]; ok && b.capacity > 0 {
+		b.tokens = min(b.capacity, b.tokens+1)
+	}
+}
+
+func (r *RateLimiter) bucket(family string, now time.Time) *rateLimitBucket {
+	b, ok := r.buckets[family]
+	if !ok {
+		b = &rateLimitBucket{
+			capacity: float64(r.options.Limit),
+			tokens:   float64(r.options.Limit),
+			rate:     float64(r.options.Limit) / r.options.Window.Seconds(),
+			last:     now,
+		}
+		r.buckets[family] = b
+	}
+
+	return b
+}
+
+func (b *rateLimitBucket) refill(now time.Time) {
+	if !now.After(b.last) {
+		return
+	}
+
+	b.tokens = min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+}
+
+func parseRateLimitHeader(header http.Header, name string) (int, bool) {
+	value := header.Get(name)
+	if value == "" {
+		return 0, false
+	}
+
+	result, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return result, true
+}
@@ -0,0 +1,149 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultRateLimitFamily(t *testing.T) {
+	tests := []struct {
+		method string
+		url    string
+		want   string
+	}{
+		{http.MethodPost, "https://api.linode.com/v4/linode/instances", "POST linode/instances"},
+		{http.MethodGet, "https://api.linode.com/v4/linode/instances/123/disks", "GET linode/instances"},
+		{http.MethodGet, "https://api.linode.com/v4beta/volumes/123", "GET volumes"},
+		{http.MethodGet, "https://api.linode.com/v4/account/events", "GET account/events"},
+		{http.MethodGet, "https://proxy.example.com/linode/v4/vpcs/5", "GET vpcs"},
+		{http.MethodGet, "https://proxy.example.com/volumes/123", "GET volumes"},
+		{http.MethodGet, "https://proxy.example.com/vpcs/5/subnets", "GET vpcs"},
+		{http.MethodGet, "https://proxy.example.com/vlans", "GET vlans"},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, tt.url, nil)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, DefaultRateLimitFamily(req))
+	}
+}
+
+func TestRateLimiter_Throttles(t *testing.T) {
+	limiter := NewRateLimiter(RateLimiterOptions{Limit: 2, Window: time.Second})
+
+	now := time.Now()
+
+	require.Zero(t, limiter.reserve("GET linode/instances", now))
+	require.Zero(t, limiter.reserve("GET linode/instances", now))
+
+	wait := limiter.reserve("GET linode/instances", now)
+	require.InDelta(t, 500*time.Millisecond, wait, float64(10*time.Millisecond))
+
+	// Other families have their own bucket
+	require.Zero(t, limiter.reserve("POST linode/instances", now))
+}
+
+func TestRateLimiter_UpdateFromHeaders(t *testing.T) {
+	limiter := NewRateLimiter(RateLimiterOptions{})
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.linode.com/v4/linode/instances", nil)
+	require.NoError(t, err)
+
+	// Unknown limits should not throttle
+	require.Zero(t, limiter.reserve(DefaultRateLimitFamily(req), time.Now()))
+
+	reset := time.Now().Add(10 * time.Second).Truncate(time.Second)
+
+	header := make(http.Header)
+	header.Set(RateLimitLimitHeaderName, "5")
+	header.Set(RateLimitRemainingHeaderName, "0")
+	header.Set(RateLimitResetHeaderName, strconv.FormatInt(reset.Unix(), 10))
+
+	limiter.Update(&http.Response{
+		StatusCode: http.StatusOK,
+		Request:    req,
+		Header:     header,
+	})
+
+	wait := limiter.reserve(DefaultRateLimitFamily(req), time.Now())
+	require.Greater(t, wait, 8*time.Second)
+
+	getReq, err := http.NewRequest(http.MethodGet, "https://api.linode.com/v4/linode/instances", nil)
+	require.NoError(t, err)
+	require.Zero(t, limiter.reserve(DefaultRateLimitFamily(getReq), time.Now()))
+}
+
+func TestDoRequest_RateLimiterRespectsContext(t *testing.T) {
+	var calls atomic.Int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(RateLimitLimitHeaderName, "1")
+		w.Header().Set(RateLimitRemainingHeaderName, "0")
+		w.Header().Set(RateLimitResetHeaderName, strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL)
+	client.SetRateLimiter(NewRateLimiter(RateLimiterOptions{}))
+
+	require.NoError(t, client.doRequest(context.Background(), http.MethodGet, "/test", requestParams{}, nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := client.doRequest(ctx, http.MethodGet, "/test", requestParams{}, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestRateLimiter_CanceledWaitReleasesToken(t *testing.T) {
+	limiter := NewRateLimiter(RateLimiterOptions{Limit: 1, Window: time.Hour})
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.linode.com/v4/linode/instances", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, limiter.Wait(context.Background(), req))
+
+	// Canceled callers should not drain the bucket
+	for range 5 {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		require.ErrorIs(t, limiter.Wait(ctx, req), context.DeadlineExceeded)
+		cancel()
+	}
+
+	wait := limiter.reserve(DefaultRateLimitFamily(req), time.Now())
+	require.InDelta(t, time.Hour, wait, float64(time.Second))
+}
+
+func TestRateLimiter_TooManyRequestsWithUnknownLimit(t *testing.T) {
+	limiter := NewRateLimiter(RateLimiterOptions{})
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.linode.com/v4/linode/instances", nil)
+	require.NoError(t, err)
+
+	header := make(http.Header)
+	header.Set("Retry-After", "10")
+
+	limiter.Update(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Request:    req,
+		Header:     header,
+	})
+
+	wait := limiter.reserve(DefaultRateLimitFamily(req), time.Now())
+	require.Greater(t, wait, 9*time.Second)
+}
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 	"time"
 )
 
@@ -111,8 +112,8 @@ func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region,
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]Region), nil
+	if result := getCachedResponse[[]Region](ctx, c, endpoint); result != nil {
+		return *result, nil
 	}
 
 	response, err := getPaginatedResults[Region](ctx, c, "regions", opts)
@@ -120,18 +121,23 @@ func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region,
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
 
+// IterRegions returns an iterator over the results of ListRegions,
+// fetching pages lazily as they are consumed. Unlike ListRegions, results are not cached.
+func (c *Client) IterRegions(ctx context.Context, opts *ListOptions) iter.Seq2[Region, error] {
+	return getPaginatedIter[Region](ctx, c, "regions", opts)
+}
+
 // GetRegion gets the template with the provided ID. This endpoint is cached by default.
 func (c *Client) GetRegion(ctx context.Context, regionID string) (*Region, error) {
 	e := formatAPIPath("regions/%s", regionID)
 
-	if result := c.getCachedResponse(e); result != nil {
-		result := result.(Region)
-		return &result, nil
+	if result := getCachedResponse[Region](ctx, c, e); result != nil {
+		return result, nil
 	}
 
 	response, err := doGETRequest[Region](ctx, c, e)
@@ -139,7 +145,7 @@ func (c *Client) GetRegion(ctx context.Context, regionID string) (*Region, error
 		return nil, err
 	}
 
-	c.addCachedResponse(e, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, e, response, &cacheExpiryTime)
 
 	return response, nil
 }
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // RegionAvailability represents a linode region object.
@@ -27,8 +28,8 @@ func (c *Client) ListRegionsAvailability(ctx context.Context, opts *ListOptions)
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]RegionAvailability), nil
+	if result := getCachedResponse[[]RegionAvailability](ctx, c, endpoint); result != nil {
+		return *result, nil
 	}
 
 	response, err := getPaginatedResults[RegionAvailability](ctx, c, e, opts)
@@ -36,17 +37,23 @@ func (c *Client) ListRegionsAvailability(ctx context.Context, opts *ListOptions)
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
 
+// IterRegionsAvailability returns an iterator over the results of ListRegionsAvailability,
+// fetching pages lazily as they are consumed. Unlike ListRegionsAvailability, results are not cached.
+func (c *Client) IterRegionsAvailability(ctx context.Context, opts *ListOptions) iter.Seq2[RegionAvailability, error] {
+	return getPaginatedIter[RegionAvailability](ctx, c, "regions/availability", opts)
+}
+
 // GetRegionAvailability gets availability for all plans in the provided region. This endpoint is cached by default.
 func (c *Client) GetRegionAvailability(ctx context.Context, regionID string) ([]RegionAvailability, error) {
 	e := formatAPIPath("regions/%s/availability", regionID)
 
-	if result := c.getCachedResponse(e); result != nil {
-		return result.([]RegionAvailability), nil
+	if result := getCachedResponse[[]RegionAvailability](ctx, c, e); result != nil {
+		return *result, nil
 	}
 
 	response, err := doGETRequest[[]RegionAvailability](ctx, c, e)
@@ -54,7 +61,7 @@ func (c *Client) GetRegionAvailability(ctx context.Context, regionID string) ([]
 		return nil, err
 	}
 
-	c.addCachedResponse(e, *response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, e, *response, &cacheExpiryTime)
 
 	return *response, nil
 }
@@ -66,6 +73,13 @@ func (c *Client) ListRegionsVPCAvailability(ctx context.Context, opts *ListOptio
 	return getPaginatedResults[RegionVPCAvailability](ctx, c, e, opts)
 }
 
+// IterRegionsVPCAvailability returns an iterator over the results of ListRegionsVPCAvailability,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterRegionsVPCAvailability(ctx context.Context, opts *ListOptions) iter.Seq2[RegionVPCAvailability, error] {
+	e := "regions/vpc-availability"
+	return getPaginatedIter[RegionVPCAvailability](ctx, c, e, opts)
+}
+
 // GetRegionVPCAvailability gets VPC availability data for a single region.
 // NOTE: IPv6 VPCs may not currently be available to all users.
 func (c *Client) GetRegionVPCAvailability(ctx context.Context, regionID string) (*RegionVPCAvailability, error) {
@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"reflect"
+	"sync"
 )
 
 // PaginatedResponse represents a single response from a paginated
@@ -21,6 +23,8 @@ type PaginatedResponse[T any] struct {
 
 // handlePaginatedResults aggregates results from the given
 // paginated endpoint using the provided ListOptions and HTTP method.
+// If page concurrency is enabled on the ListOptions or the client, the pages following
+// the first page are fetched concurrently while preserving their order.
 // nolint:funlen
 func handlePaginatedResults[T any, O any](
 	ctx context.Context,
@@ -30,6 +34,9 @@ func handlePaginatedResults[T any, O any](
 	method string,
 	options ...O,
 ) ([]T, error) {
+	ctx, cancel := withCallTimeout(ctx)
+	defer cancel()
+
 	result := make([]T, 0)
 
 	if opts == nil {
@@ -40,44 +47,19 @@ func handlePaginatedResults[T any, O any](
 		opts.PageOptions = &PageOptions{Page: 0}
 	}
 
-	// Validate options
-	numOpts := len(options)
-	if numOpts > 1 {
-		return nil, fmt.Errorf("invalid number of options: expected 0 or 1, got %d", numOpts)
+	reqBody, err := marshalPaginatedRequestBody(options...)
+	if err != nil {
+		return nil, err
 	}
 
-	// Prepare request body if options are provided
-	var reqBody string
-
-	if numOpts > 0 && !isNil(options[0]) {
-		body, err := json.Marshal(options[0])
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal request body: %w", err)
-		}
-
-		reqBody = string(body)
+	// Makes a request to a particular page using the given list options
+	fetchPage := func(ctx context.Context, pageOpts *ListOptions, page int) (*PaginatedResponse[T], error) {
+		return fetchPaginatedPage[T](ctx, client, endpoint, method, reqBody, pageOpts, page)
 	}
 
 	// Makes a request to a particular page and appends the response to the result
 	handlePage := func(page int) error {
-		var resultType PaginatedResponse[T]
-
-		// Override the page to be applied in createListOptionsToRequestMutator(...)
-		opts.Page = page
-
-		params := requestParams{
-			Response: &resultType,
-		}
-
-		if reqBody != "" {
-			params.Body = bytes.NewReader([]byte(reqBody))
-		}
-
-		// Create a mutator to apply all user-provided list options to the request
-		mutator := createListOptionsToRequestMutator(opts)
-
-		// Make the request using doRequest
-		err := client.doRequest(ctx, method, endpoint, params, &mutator)
+		resultType, err := fetchPage(ctx, opts, page)
 		if err != nil {
 			return err
 		}
@@ -111,6 +93,26 @@ func handlePaginatedResults[T any, O any](
 		return result, nil
 	}
 
+	concurrency := client.pageConcurrency
+	if opts.PageConcurrency > 0 {
+		concurrency = opts.PageConcurrency
+	}
+
+	if concurrency > 1 && opts.Pages > 2 {
+		remaining, err := fetchPagesConcurrently(ctx, opts, 2, opts.Pages, concurrency, fetchPage)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, page := range remaining {
+			result = append(result, page...)
+		}
+
+		opts.Page = opts.Pages
+
+		return result, nil
+	}
+
 	// Get the remaining pages
 	for page := 2; page <= opts.Pages; page++ {
 		if err := handlePage(page); err != nil {
@@ -121,6 +123,179 @@ func handlePaginatedResults[T any, O any](
 	return result, nil
 }
 
+// iteratePaginatedResults returns an iterator over the results from the given
+// paginated endpoint using the provided ListOptions and HTTP method.
+// Pages are only fetched as the iterator is consumed, and no further pages are
+// fetched once the consumer stops iterating. If a request fails, the error is
+// yielded with the zero value of T and iteration ends.
+func iteratePaginatedResults[T any, O any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	opts *ListOptions,
+	method string,
+	options ...O,
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		ctx, cancel := withCallTimeout(ctx)
+		defer cancel()
+
+		reqBody, err := marshalPaginatedRequestBody(options...)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+
+		// Copy the options so the iterator can be consumed more than once
+		pageOpts := ListOptions{PageOptions: &PageOptions{}}
+		if opts != nil {
+			pageOpts = *opts
+			pageOpts.PageOptions = &PageOptions{}
+
+			if opts.PageOptions != nil {
+				*pageOpts.PageOptions = *opts.PageOptions
+			}
+		}
+
+		// If a specific page is defined, only that page is iterated
+		pageDefined := pageOpts.Page > 0
+
+		page := 1
+		if pageDefined {
+			page = pageOpts.Page
+		}
+
+		for {
+			resultType, err := fetchPaginatedPage[T](ctx, client, endpoint, method, reqBody, &pageOpts, page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, entry := range resultType.Data {
+				if !yield(entry, nil) {
+					return
+				}
+			}
+
+			if pageDefined || page >= resultType.Pages {
+				return
+			}
+
+			page++
+		}
+	}
+}
+
+// marshalPaginatedRequestBody marshals the optional request body for a paginated request.
+func marshalPaginatedRequestBody[O any](options ...O) (string, error) {
+	// Validate options
+	numOpts := len(options)
+	if numOpts > 1 {
+		return "", fmt.Errorf("invalid number of options: expected 0 or 1, got %d", numOpts)
+	}
+
+	if numOpts == 0 || isNil(options[0]) {
+		return "", nil
+	}
+
+	body, err := json.Marshal(options[0])
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	return string(body), nil
+}
+
+// fetchPaginatedPage makes a request to a particular page of the given
+// paginated endpoint using the provided ListOptions.
+func fetchPaginatedPage[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint, method, reqBody string,
+	opts *ListOptions,
+	page int,
+) (*PaginatedResponse[T], error) {
+	var resultType PaginatedResponse[T]
+
+	// Override the page to be applied in createListOptionsToRequestMutator(...)
+	opts.Page = page
+
+	params := requestParams{
+		Response: &resultType,
+	}
+
+	if reqBody != "" {
+		params.Body = bytes.NewReader([]byte(reqBody))
+	}
+
+	// Create a mutator to apply all user-provided list options to the request
+	mutator := createListOptionsToRequestMutator(opts)
+
+	// Make the request using doRequest
+	if err := client.doRequest(ctx, method, endpoint, params, &mutator); err != nil {
+		return nil, err
+	}
+
+	return &resultType, nil
+}
+
+// fetchPagesConcurrently fetches the pages in the range [first, last] using at most
+// concurrency simultaneous requests and returns the data of each page in order.
+// The first error cancels all in-flight requests and is returned.
+func fetchPagesConcurrently[T any](
+	ctx context.Context,
+	opts *ListOptions,
+	first, last, concurrency int,
+	fetchPage func(context.Context, *ListOptions, int) (*PaginatedResponse[T], error),
+) ([][]T, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	results := make([][]T, last-first+1)
+	semaphore := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+
+	for page := first; page <= last; page++ {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		// Each page needs its own ListOptions since the page number is
+		// applied when the request is built.
+		pageOpts := *opts
+		pageOpts.PageOptions = &PageOptions{}
+
+		wg.Go(func() {
+			defer func() { <-semaphore }()
+
+			resultType, err := fetchPage(ctx, &pageOpts, page)
+			if err != nil {
+				cancel(err)
+				return
+			}
+
+			results[page-first] = resultType.Data
+		})
+	}
+
+	wg.Wait()
+
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 // getPaginatedResults aggregates results from the given
 // paginated endpoint using the provided ListOptions.
 func getPaginatedResults[T any](
@@ -132,6 +307,17 @@ func getPaginatedResults[T any](
 	return handlePaginatedResults[T, any](ctx, client, endpoint, opts, "GET")
 }
 
+// getPaginatedIter returns an iterator over the results from the given
+// paginated endpoint using the provided ListOptions.
+func getPaginatedIter[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	opts *ListOptions,
+) iter.Seq2[T, error] {
+	return iteratePaginatedResults[T, any](ctx, client, endpoint, opts, "GET")
+}
+
 // putPaginatedResults sends a PUT request and aggregates the results from the given
 // paginated endpoint using the provided ListOptions.
 func putPaginatedResults[T, O any](
@@ -194,6 +380,8 @@ func doPOSTRequest[T, O any](
 
 	params := requestParams{
 		Response: &resultType,
+		// The idempotency key is resolved once so it's stable across retries
+		Headers: client.idempotencyHeaders(ctx),
 	}
 
 	if numOpts > 0 && !isNil(options[0]) {
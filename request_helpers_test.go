@@ -7,7 +7,10 @@ import (
 	"net/http"
 	"reflect"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/jarcoal/httpmock"
@@ -224,6 +227,158 @@ func TestRequestHelpers_paginateSingle(t *testing.T) {
 	}
 }
 
+func TestRequestHelpers_paginateConcurrent(t *testing.T) {
+	const totalResults = 4123
+
+	client := testutil.CreateMockClientWithError(t, NewClient)
+	client.SetPageConcurrency(4)
+
+	var (
+		mu          sync.Mutex
+		numRequests int
+		inFlight    atomic.Int32
+		maxInFlight atomic.Int32
+	)
+
+	responder := mockPaginatedResponse(buildPaginatedEntries(totalResults), &numRequests)
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/foo/bar"),
+		func(request *http.Request) (*http.Response, error) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+
+			for {
+				seen := maxInFlight.Load()
+				if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+
+			// Give other requests a chance to overlap with this one
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			return responder(request)
+		})
+
+	opts := &ListOptions{PageSize: 100}
+
+	response, err := getPaginatedResults[testResultType](context.Background(), client, "/foo/bar", opts)
+	require.NoError(t, err)
+
+	require.Equal(t, 42, numRequests)
+	require.Len(t, response, totalResults)
+	require.LessOrEqual(t, maxInFlight.Load(), int32(4))
+	require.Greater(t, maxInFlight.Load(), int32(1))
+	require.Equal(t, 42, opts.Pages)
+
+	for i := range totalResults {
+		require.Equal(t, i, response[i].ID)
+	}
+}
+
+func TestRequestHelpers_paginateConcurrentError(t *testing.T) {
+	client := testutil.CreateMockClientWithError(t, NewClient)
+	client.SetRetryCount(0)
+
+	var (
+		mu          sync.Mutex
+		numRequests int
+	)
+
+	responder := mockPaginatedResponse(buildPaginatedEntries(300), &numRequests)
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/foo/bar"),
+		func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("page") == "3" {
+				return httpmock.NewJsonResponse(http.StatusInternalServerError, APIError{
+					Errors: []APIErrorReason{{Reason: "page 3 failed"}},
+				})
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			return responder(request)
+		})
+
+	_, err := getPaginatedResults[testResultType](context.Background(), client, "/foo/bar", &ListOptions{
+		PageConcurrency: 2,
+	})
+	require.ErrorContains(t, err, "page 3 failed")
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	require.Less(t, numRequests, 100, "expected the remaining pages to be canceled")
+}
+
+func TestRequestHelpers_paginateIter(t *testing.T) {
+	const totalResults = 25
+
+	client := testutil.CreateMockClientWithError(t, NewClient)
+
+	numRequests := 0
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/foo/bar"),
+		mockPaginatedResponse(buildPaginatedEntries(totalResults), &numRequests))
+
+	opts := &ListOptions{PageSize: 10}
+
+	var ids []int
+
+	for entry, err := range getPaginatedIter[testResultType](context.Background(), client, "/foo/bar", opts) {
+		require.NoError(t, err)
+
+		ids = append(ids, entry.ID)
+	}
+
+	require.Equal(t, 3, numRequests)
+	require.Len(t, ids, totalResults)
+
+	for i, id := range ids {
+		require.Equal(t, i, id)
+	}
+
+	// The caller's options should not be mutated by the iterator
+	require.Nil(t, opts.PageOptions)
+
+	// Breaking out early should stop fetching further pages
+	numRequests = 0
+
+	for entry, err := range getPaginatedIter[testResultType](context.Background(), client, "/foo/bar", opts) {
+		require.NoError(t, err)
+
+		if entry.ID == 12 {
+			break
+		}
+	}
+
+	require.Equal(t, 2, numRequests)
+}
+
+func TestRequestHelpers_paginateIterError(t *testing.T) {
+	client := testutil.CreateMockClientWithError(t, NewClient)
+	client.SetRetryCount(0)
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/foo/bar"),
+		httpmock.NewJsonResponderOrPanic(http.StatusNotFound, APIError{
+			Errors: []APIErrorReason{{Reason: "Not found"}},
+		}))
+
+	numYields := 0
+
+	for _, err := range getPaginatedIter[testResultType](context.Background(), client, "/foo/bar", nil) {
+		numYields++
+
+		require.True(t, IsNotFound(err))
+	}
+
+	require.Equal(t, 1, numYields)
+}
+
 func buildPaginatedEntries(numEntries int) []testResultType {
 	result := make([]testResultType, numEntries)
 
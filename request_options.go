@@ -0,0 +1,109 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RequestOption configures the requests made using a context.
+// See WithRequestOptions.
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	headers        http.Header
+	timeout        time.Duration
+	skipCache      bool
+	apiVersion     string
+	disableRetries bool
+}
+
+type requestOptionsContextKey struct{}
+
+// WithRequestOptions returns a copy of ctx that applies the given options to
+// requests made with it. Options are added to any options already set on ctx.
+//
+// Unlike the client's Set* methods, request options do not modify shared
+// client state, so a single client can serve calls that need different behaviour:
+//
+//	ctx = linodego.WithRequestOptions(ctx,
+//		linodego.WithAPIVersion("v4beta"),
+//		linodego.WithRequestTimeout(30*time.Second),
+//	)
+//	instance, err := client.GetInstance(ctx, instanceID)
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	options := requestOptionsFromContext(ctx)
+	options.headers = options.headers.Clone()
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return context.WithValue(ctx, requestOptionsContextKey{}, options)
+}
+
+// WithHeader sets a header on each request.
+// Request headers take priority over headers set using Client.SetHeader.
+func WithHeader(name, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.headers == nil {
+			o.headers = make(http.Header)
+		}
+
+		o.headers.Set(name, value)
+	}
+}
+
+// WithRequestTimeout limits the time a call may take, including any retries.
+// The timeout applies to calls as a whole, e.g. to all pages fetched by a List method,
+// and for iterators, from the start of the iteration.
+func WithRequestTimeout(timeout time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithoutCache skips the response cache when reading cached endpoints.
+// Fresh responses are still stored in the cache.
+func WithoutCache() RequestOption {
+	return func(o *requestOptions) {
+		o.skipCache = true
+	}
+}
+
+// WithAPIVersion sends requests to the given API version, e.g. "v4beta",
+// instead of the version configured on the client.
+func WithAPIVersion(apiVersion string) RequestOption {
+	return func(o *requestOptions) {
+		o.apiVersion = apiVersion
+	}
+}
+
+// WithoutRetries disables retries, so each request is attempted once.
+func WithoutRetries() RequestOption {
+	return func(o *requestOptions) {
+		o.disableRetries = true
+	}
+}
+
+// requestOptionsFromContext returns the request options set on ctx.
+func requestOptionsFromContext(ctx context.Context) requestOptions {
+	options, _ := ctx.Value(requestOptionsContextKey{}).(requestOptions)
+	return options
+}
+
+// withCallTimeout applies the request timeout set on ctx, if any, returning a context that
+// limits the whole call. The returned context no longer applies the timeout itself, so the
+// requests of a call made up of many requests (e.g. the pages of a list) share it.
+func withCallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	options := requestOptionsFromContext(ctx)
+	if options.timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, options.timeout)
+
+	options.timeout = 0
+
+	return context.WithValue(ctx, requestOptionsContextKey{}, options), cancel
+}
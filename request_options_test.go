@@ -0,0 +1,141 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRequestOptions(t *testing.T) {
+	var (
+		requests  atomic.Int32
+		lastPath  atomic.Value
+		lastValue atomic.Value
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		lastPath.Store(r.URL.Path)
+		lastValue.Store(r.Header.Get("X-Test"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "us-east"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetHeader("X-Test", "client")
+
+	ctx := WithRequestOptions(context.Background(), WithHeader("X-Test", "request"))
+
+	_, err := client.GetRegion(ctx, "us-east")
+	require.NoError(t, err)
+	require.Equal(t, "request", lastValue.Load())
+	require.Equal(t, "/v4/regions/us-east", lastPath.Load())
+
+	// Options are added to those already set on the context
+	ctx = WithRequestOptions(ctx, WithAPIVersion("v4beta"), WithoutCache())
+
+	_, err = client.GetRegion(ctx, "us-east")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), requests.Load())
+	require.Equal(t, "request", lastValue.Load())
+	require.Equal(t, "/v4beta/regions/us-east", lastPath.Load())
+
+	// The client is not modified by request options, and responses to requests
+	// with request headers are not cached
+	_, err = client.GetRegion(context.Background(), "us-east")
+	require.NoError(t, err)
+	require.Equal(t, int32(3), requests.Load())
+	require.Equal(t, "/v4/regions/us-east", lastPath.Load())
+
+	_, err = client.GetRegion(context.Background(), "us-east")
+	require.NoError(t, err)
+	require.Equal(t, int32(3), requests.Load(), "expected a cached response")
+
+	// Responses are cached separately for each API version
+	betaCtx := WithRequestOptions(context.Background(), WithAPIVersion("v4beta"))
+
+	_, err = client.GetRegion(betaCtx, "us-east")
+	require.NoError(t, err)
+	require.Equal(t, int32(4), requests.Load())
+	require.Equal(t, "/v4beta/regions/us-east", lastPath.Load())
+
+	_, err = client.GetRegion(betaCtx, "us-east")
+	require.NoError(t, err)
+	require.Equal(t, int32(4), requests.Load(), "expected a cached response")
+
+	_, err = client.GetType(context.Background(), "g6-nanode-1")
+	require.NoError(t, err)
+	require.Equal(t, "client", lastValue.Load())
+	require.Equal(t, "/v4/linode/types/g6-nanode-1", lastPath.Load())
+}
+
+func TestWithRequestOptions_RetriesAndTimeout(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if r.URL.Path == "/v4/linode/instances" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"errors": [{"reason": "unavailable"}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).
+		SetRetryCount(3).
+		SetRetryWaitTime(time.Millisecond).
+		SetRetryMaxWaitTime(time.Millisecond)
+
+	_, err := client.GetInstance(WithRequestOptions(context.Background(), WithoutRetries()), 123)
+	require.True(t, ErrHasStatus(err, http.StatusServiceUnavailable))
+	require.Equal(t, int32(1), requests.Load())
+
+	_, err = client.GetInstance(context.Background(), 123)
+	require.True(t, ErrHasStatus(err, http.StatusServiceUnavailable))
+	require.Equal(t, int32(5), requests.Load())
+
+	ctx := WithRequestOptions(context.Background(), WithRequestTimeout(50*time.Millisecond))
+	start := time.Now()
+
+	_, err = client.ListInstances(ctx, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestWithRequestTimeout_AppliesToWholeCall(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		time.Sleep(20 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [{"id": 1}], "page": 1, "pages": 100, "results": 100}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL)
+
+	// Each page is fetched well within the timeout, but all of them are not
+	ctx := WithRequestOptions(context.Background(), WithRequestTimeout(100*time.Millisecond))
+
+	_, err := client.ListInstances(ctx, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, requests.Load(), int32(10))
+}
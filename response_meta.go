@@ -0,0 +1,130 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	RequestIDHeaderName           = "X-Request-Id"
+	OAuthScopesHeaderName         = "X-OAuth-Scopes"
+	AcceptedOAuthScopesHeaderName = "X-Accepted-OAuth-Scopes"
+)
+
+// ResponseMeta is the metadata of the response to a request, such as its status and headers.
+// It is captured for requests made using a context returned by WithResponseMeta.
+type ResponseMeta struct {
+	// StatusCode is the status code of the final response.
+	// It is 0 if no response was received.
+	StatusCode int
+
+	// Header contains the headers of the final response.
+	Header http.Header
+
+	// Attempts is the number of attempts made, including retries.
+	Attempts int
+
+	// URL is the URL of the final request.
+	URL string
+}
+
+// ResponseRateLimit is the rate limit reported in the headers of a response.
+type ResponseRateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+type responseMetaContextKey struct{}
+
+// responseMetaCapture guards the ResponseMeta of a context, since
+// paginated List calls may make requests concurrently.
+type responseMetaCapture struct {
+	mu   sync.Mutex
+	meta *ResponseMeta
+}
+
+// WithResponseMeta returns a copy of ctx that captures the metadata of the responses to
+// requests made with it into meta:
+//
+//	var meta linodego.ResponseMeta
+//	instance, err := client.GetInstance(linodego.WithResponseMeta(ctx, &meta), instanceID)
+//	log.Printf("request %s used scopes %v", meta.RequestID(), meta.OAuthScopes())
+//
+// meta is filled for failed requests too; its StatusCode is 0 if no response was received.
+// If multiple requests are made with the context, e.g. by a paginated List call,
+// meta holds the metadata of the last one. Responses served from the client's cache
+// do not update meta.
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaContextKey{}, &responseMetaCapture{meta: meta})
+}
+
+// RequestID returns the identifier of the request assigned by the API, if any.
+// It can be included in support tickets.
+func (m *ResponseMeta) RequestID() string {
+	return m.Header.Get(RequestIDHeaderName)
+}
+
+// OAuthScopes returns the OAuth scopes of the token used for the request.
+func (m *ResponseMeta) OAuthScopes() []string {
+	return splitOAuthScopes(m.Header.Get(OAuthScopesHeaderName))
+}
+
+// AcceptedOAuthScopes returns the OAuth scopes accepted by the endpoint of the request.
+func (m *ResponseMeta) AcceptedOAuthScopes() []string {
+	return splitOAuthScopes(m.Header.Get(AcceptedOAuthScopesHeaderName))
+}
+
+// RateLimit returns the rate limit reported by the API, and whether it was reported.
+func (m *ResponseMeta) RateLimit() (ResponseRateLimit, bool) {
+	var rateLimit ResponseRateLimit
+
+	limit, hasLimit := parseRateLimitHeader(m.Header, RateLimitLimitHeaderName)
+	remaining, hasRemaining := parseRateLimitHeader(m.Header, RateLimitRemainingHeaderName)
+
+	if !hasLimit && !hasRemaining {
+		return rateLimit, false
+	}
+
+	rateLimit.Limit = limit
+	rateLimit.Remaining = remaining
+
+	if resetUnix, ok := parseRateLimitHeader(m.Header, RateLimitResetHeaderName); ok {
+		rateLimit.Reset = time.Unix(int64(resetUnix), 0)
+	}
+
+	return rateLimit, true
+}
+
+// captureResponseMeta fills the ResponseMeta of ctx, if any, using the final response of a request.
+func captureResponseMeta(ctx context.Context, attempts int, resp *http.Response) {
+	capture, ok := ctx.Value(responseMetaContextKey{}).(*responseMetaCapture)
+	if !ok || capture.meta == nil {
+		return
+	}
+
+	meta := ResponseMeta{Attempts: attempts}
+
+	if resp != nil {
+		meta.StatusCode = resp.StatusCode
+		meta.Header = resp.Header.Clone()
+
+		if resp.Request != nil && resp.Request.URL != nil {
+			meta.URL = resp.Request.URL.String()
+		}
+	}
+
+	capture.mu.Lock()
+	defer capture.mu.Unlock()
+
+	*capture.meta = meta
+}
+
+func splitOAuthScopes(scopes string) []string {
+	return strings.FieldsFunc(scopes, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+}
@@ -0,0 +1,68 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ResponseMeta(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(RequestIDHeaderName, "request-123")
+		w.Header().Set(OAuthScopesHeaderName, "linodes:read_only volumes:read_write")
+		w.Header().Set(AcceptedOAuthScopesHeaderName, "linodes:read_write")
+		w.Header().Set(RateLimitLimitHeaderName, "800")
+		w.Header().Set(RateLimitRemainingHeaderName, "799")
+		w.Header().Set(RateLimitResetHeaderName, "1700000000")
+
+		switch r.URL.Path {
+		case "/v4/linode/types/g6-nanode-1":
+			if requests.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(`{"errors": [{"reason": "Service Unavailable"}]}`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"id": "g6-nanode-1"}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Unauthorized"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond)
+
+	var meta ResponseMeta
+
+	_, err := client.GetType(WithResponseMeta(context.Background(), &meta), "g6-nanode-1")
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, meta.StatusCode)
+	require.Equal(t, 2, meta.Attempts)
+	require.Equal(t, server.URL+"/v4/linode/types/g6-nanode-1", meta.URL)
+	require.Equal(t, "request-123", meta.RequestID())
+	require.Equal(t, []string{"linodes:read_only", "volumes:read_write"}, meta.OAuthScopes())
+	require.Equal(t, []string{"linodes:read_write"}, meta.AcceptedOAuthScopes())
+
+	rateLimit, ok := meta.RateLimit()
+	require.True(t, ok)
+	require.Equal(t, ResponseRateLimit{Limit: 800, Remaining: 799, Reset: time.Unix(1700000000, 0)}, rateLimit)
+
+	// Metadata is captured for failed requests
+	_, err = client.UpdateInstance(WithResponseMeta(context.Background(), &meta), 123, InstanceUpdateOptions{})
+	require.Error(t, err)
+	require.Equal(t, http.StatusUnauthorized, meta.StatusCode)
+	require.Equal(t, 1, meta.Attempts)
+	require.Equal(t, "request-123", meta.RequestID())
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"io"
 	"log"
+	"math/rand/v2"
 	"net/http"
 	"strconv"
 	"time"
@@ -25,6 +26,12 @@ type RetryConditional func(*http.Response, error) bool
 // RetryAfter is a type alias for a function that determines the duration to wait before retrying based on the response.
 type RetryAfter func(*http.Response) (time.Duration, error)
 
+// BackoffStrategy is a type alias for a function that determines the duration to wait before a retry
+// when the server did not provide a Retry-After duration.
+// attempt starts at 1 for the first retry, previous is the wait used before the previous retry
+// (0 for the first retry) and minWait/maxWait are the bounds configured on the client.
+type BackoffStrategy func(attempt int, previous, minWait, maxWait time.Duration) time.Duration
+
 // ConfigureRetries configures http.Client to lock until enough time has passed to retry the request as determined by the Retry-After response header.
 // If the Retry-After header is not set, we fall back to the value of SetPollDelay.
 func ConfigureRetries(c *Client) {
@@ -53,6 +60,45 @@ func RespectRetryAfter(resp *http.Response) (time.Duration, error) {
 	return duration, nil
 }
 
+// Backoff strategies
+
+// ConstantBackoff always waits for the configured minimum wait time.
+// This is the default BackoffStrategy.
+func ConstantBackoff(_ int, _, minWait, _ time.Duration) time.Duration {
+	return minWait
+}
+
+// ExponentialBackoff doubles the wait time for every attempt starting at the configured minimum wait time,
+// with full jitter applied to the result.
+func ExponentialBackoff(attempt int, _, minWait, maxWait time.Duration) time.Duration {
+	wait := minWait
+
+	for i := 1; i < attempt && wait < maxWait; i++ {
+		wait *= 2
+	}
+
+	wait = min(wait, maxWait)
+
+	if wait <= minWait {
+		return minWait
+	}
+
+	return minWait + rand.N(wait-minWait+1) //nolint:gosec // jitter does not need a secure source
+}
+
+// DecorrelatedJitterBackoff picks a random wait time between the configured minimum wait time
+// and three times the previous wait time, as described in
+// https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/.
+func DecorrelatedJitterBackoff(_ int, previous, minWait, maxWait time.Duration) time.Duration {
+	upper := min(max(previous, minWait)*3, maxWait)
+
+	if upper <= minWait {
+		return minWait
+	}
+
+	return minWait + rand.N(upper-minWait+1) //nolint:gosec // jitter does not need a secure source
+}
+
 // Retry conditions
 
 func LinodeBusyRetryCondition(resp *http.Response, _ error) bool {
@@ -36,6 +36,16 @@ func TestLinodeBusyRetryCondition(t *testing.T) {
 	if !retry {
 		t.Errorf("Should have retried")
 	}
+
+	// Only the exact "Linode busy." error is retried, although ErrEntityBusy matches other busy entities
+	apiError.Errors = []APIErrorReason{{Reason: "Volume busy."}}
+	rawResponse.Body = createResponseBody(apiError)
+
+	retry = LinodeBusyRetryCondition(rawResponse, nil)
+
+	if retry {
+		t.Errorf("Should not have retried")
+	}
 }
 
 func TestServiceUnavailableRetryCondition(t *testing.T) {
@@ -79,3 +89,33 @@ func createResponseBody(obj interface{}) io.ReadCloser {
 	}
 	return io.NopCloser(bytes.NewBuffer(body))
 }
+
+func TestBackoffStrategies(t *testing.T) {
+	minWait := 100 * time.Millisecond
+	maxWait := 2 * time.Second
+
+	if wait := ConstantBackoff(5, time.Second, minWait, maxWait); wait != minWait {
+		t.Errorf("expected constant backoff to return %s, got %s", minWait, wait)
+	}
+
+	for attempt := 1; attempt <= 10; attempt++ {
+		upper := min(minWait<<(attempt-1), maxWait)
+
+		wait := ExponentialBackoff(attempt, 0, minWait, maxWait)
+		if wait < minWait || wait > upper {
+			t.Errorf("attempt %d: expected exponential backoff within [%s, %s], got %s", attempt, minWait, upper, wait)
+		}
+	}
+
+	previous := time.Duration(0)
+	for attempt := 1; attempt <= 10; attempt++ {
+		upper := min(max(previous, minWait)*3, maxWait)
+
+		wait := DecorrelatedJitterBackoff(attempt, previous, minWait, maxWait)
+		if wait < minWait || wait > upper {
+			t.Errorf("attempt %d: expected decorrelated jitter backoff within [%s, %s], got %s", attempt, minWait, upper, wait)
+		}
+
+		previous = wait
+	}
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -115,6 +116,12 @@ func (c *Client) ListStackscripts(ctx context.Context, opts *ListOptions) ([]Sta
 	return getPaginatedResults[Stackscript](ctx, c, "linode/stackscripts", opts)
 }
 
+// IterStackscripts returns an iterator over the results of ListStackscripts,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterStackscripts(ctx context.Context, opts *ListOptions) iter.Seq2[Stackscript, error] {
+	return getPaginatedIter[Stackscript](ctx, c, "linode/stackscripts", opts)
+}
+
 // GetStackscript gets the Stackscript with the provided ID
 func (c *Client) GetStackscript(ctx context.Context, scriptID int) (*Stackscript, error) {
 	e := formatAPIPath("linode/stackscripts/%d", scriptID)
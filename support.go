@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 	"time"
 )
 
@@ -48,6 +49,12 @@ func (c *Client) ListTickets(ctx context.Context, opts *ListOptions) ([]Ticket,
 	return getPaginatedResults[Ticket](ctx, c, "support/tickets", opts)
 }
 
+// IterTickets returns an iterator over the results of ListTickets,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterTickets(ctx context.Context, opts *ListOptions) iter.Seq2[Ticket, error] {
+	return getPaginatedIter[Ticket](ctx, c, "support/tickets", opts)
+}
+
 // GetTicket gets a Support Ticket on the Account with the specified ID
 func (c *Client) GetTicket(ctx context.Context, ticketID int) (*Ticket, error) {
 	e := formatAPIPath("support/tickets/%d", ticketID)
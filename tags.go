@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"iter"
 )
 
 // Tag represents a Tag object
@@ -56,6 +57,12 @@ func (c *Client) ListTags(ctx context.Context, opts *ListOptions) ([]Tag, error)
 	return getPaginatedResults[Tag](ctx, c, "tags", opts)
 }
 
+// IterTags returns an iterator over the results of ListTags,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterTags(ctx context.Context, opts *ListOptions) iter.Seq2[Tag, error] {
+	return getPaginatedIter[Tag](ctx, c, "tags", opts)
+}
+
 // fixData stores an object of the type defined by Type in Data using RawData
 func (i *TaggedObject) fixData() (*TaggedObject, error) {
 	switch i.Type {
@@ -122,6 +129,22 @@ func (c *Client) ListTaggedObjects(ctx context.Context, label string, opts *List
 	return response, nil
 }
 
+// IterTaggedObjects returns an iterator over the results of ListTaggedObjects,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterTaggedObjects(ctx context.Context, label string, opts *ListOptions) iter.Seq2[TaggedObject, error] {
+	return func(yield func(TaggedObject, error) bool) {
+		for object, err := range getPaginatedIter[TaggedObject](ctx, c, formatAPIPath("tags/%s", label), opts) {
+			if err == nil {
+				_, err = object.fixData()
+			}
+
+			if !yield(object, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
 // SortedObjects converts a list of TaggedObjects into a Sorted Objects struct, for easier access
 func (t TaggedObjectList) SortedObjects() (SortedObjects, error) {
 	so := SortedObjects{}
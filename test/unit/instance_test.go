@@ -45,6 +45,35 @@ func TestInstances_List(t *testing.T) {
 	assert.Equal(t, "linode/migrate", linode.MaintenancePolicy)
 }
 
+func TestInstances_Iter(t *testing.T) {
+	fixtures := NewTestFixtures()
+
+	fixtureData, err := fixtures.GetFixture("linodes_list")
+	if err != nil {
+		t.Fatalf("Failed to load fixture: %v", err)
+	}
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("linode/instances", fixtureData)
+
+	var instances []linodego.Instance
+
+	for instance, err := range base.Client.IterInstances(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("Error iterating instances: %v", err)
+		}
+
+		instances = append(instances, instance)
+	}
+
+	assert.Equal(t, 1, len(instances))
+	assert.Equal(t, 123, instances[0].ID)
+	assert.Equal(t, "linode123", instances[0].Label)
+}
+
 func TestInstance_Get(t *testing.T) {
 	fixtures := NewTestFixtures()
 
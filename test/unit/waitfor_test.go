@@ -2,6 +2,7 @@ package unit
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"regexp"
 	"testing"
@@ -134,6 +135,194 @@ func TestEventPollerWaitForFinished(t *testing.T) {
 	}
 }
 
+func TestWaitForInstanceStatusPollOptions(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Hour)
+
+	step := 0
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		func(_ *http.Request) (*http.Response, error) {
+			step++
+
+			switch step {
+			case 1, 3:
+				return httpmock.NewJsonResponse(http.StatusInternalServerError, map[string]any{
+					"errors": []map[string]string{{"reason": "Internal Server Error"}},
+				})
+			case 4:
+				return httpmock.NewJsonResponse(http.StatusOK, linodego.Instance{ID: 123, Status: linodego.InstanceRunning})
+			default:
+				return httpmock.NewJsonResponse(http.StatusOK, linodego.Instance{ID: 123, Status: linodego.InstanceProvisioning})
+			}
+		})
+
+	var attempts []linodego.PollAttempt
+
+	ctx := linodego.WithPollOptions(waitTestContext(t, time.Second), linodego.PollOptions{
+		InitialInterval:    time.Millisecond,
+		MaxInterval:        4 * time.Millisecond,
+		Jitter:             0.5,
+		MaxTransientErrors: 2,
+		OnPoll: func(attempt linodego.PollAttempt) {
+			attempts = append(attempts, attempt)
+		},
+	})
+
+	instance, err := client.WaitForInstanceStatus(ctx, 123, linodego.InstanceRunning)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Status != linodego.InstanceRunning {
+		t.Fatalf("expected instance to be running, got %s", instance.Status)
+	}
+
+	if len(attempts) != 4 || attempts[0].Err == nil || !attempts[3].Done || attempts[3].Attempt != 4 {
+		t.Fatalf("unexpected poll attempts: %+v", attempts)
+	}
+
+	// Transient errors beyond the budget abort the wait
+	step = 0
+
+	ctx = linodego.WithPollOptions(waitTestContext(t, time.Second), linodego.PollOptions{
+		InitialInterval:    time.Millisecond,
+		MaxTransientErrors: 1,
+	})
+
+	if _, err := client.WaitForInstanceStatus(ctx, 123, linodego.InstanceRunning); !linodego.ErrHasStatus(err, http.StatusInternalServerError) {
+		t.Fatalf("expected internal server error, got %v", err)
+	}
+}
+
+func TestWaitForVolumeStatusPollTimeout(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes/123"),
+		func(_ *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, linodego.Volume{ID: 123, Status: linodego.VolumeCreating})
+		})
+
+	ctx := linodego.WithPollOptions(context.Background(), linodego.PollOptions{
+		InitialInterval: time.Millisecond,
+		Timeout:         10 * time.Millisecond,
+	})
+
+	_, err := client.WaitForVolumeStatus(ctx, 123, linodego.VolumeActive)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestEventPollerWaitForFinishedPollOptions(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Hour)
+
+	listEventsCount := 0
+	httpmock.RegisterRegexpResponder("GET", regexp.MustCompile(`/[a-zA-Z0-9]+/account/events(\?.*)?$`),
+		func(_ *http.Request) (*http.Response, error) {
+			listEventsCount++
+
+			if listEventsCount == 2 {
+				return httpmock.NewJsonResponse(http.StatusInternalServerError, map[string]any{
+					"errors": []map[string]string{{"reason": "Internal Server Error"}},
+				})
+			}
+
+			events := []linodego.Event{}
+			if listEventsCount > 2 {
+				events = append(events, linodego.Event{
+					ID:     456,
+					Status: linodego.EventStarted,
+					Action: linodego.ActionLinodeBoot,
+					Entity: &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode},
+				})
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data":    events,
+				"page":    1,
+				"pages":   1,
+				"results": len(events),
+			})
+		})
+
+	poller, err := client.NewEventPoller(context.Background(), 123, linodego.EntityLinode, linodego.ActionLinodeBoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getEventCount := 0
+	httpmock.RegisterRegexpResponder("GET", regexp.MustCompile(`/[a-zA-Z0-9]+/account/events/456$`),
+		func(_ *http.Request) (*http.Response, error) {
+			getEventCount++
+
+			if getEventCount == 1 {
+				return httpmock.NewJsonResponse(http.StatusInternalServerError, map[string]any{
+					"errors": []map[string]string{{"reason": "Internal Server Error"}},
+				})
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, linodego.Event{ID: 456, Status: linodego.EventFinished})
+		})
+
+	// Transient errors while finding and polling the event are tolerated within the budget,
+	// and the client's poll delay is not used
+	ctx := linodego.WithPollOptions(waitTestContext(t, time.Second), linodego.PollOptions{
+		InitialInterval:    time.Millisecond,
+		MaxTransientErrors: 1,
+	})
+
+	event, err := poller.WaitForFinished(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.Status != linodego.EventFinished {
+		t.Fatalf("expected event to be finished, got %s", event.Status)
+	}
+}
+
+func TestWaitForLKEClusterConditionsPollOptions(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Hour)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "lke/clusters/123/kubeconfig"),
+		httpmock.NewStringResponder(http.StatusOK, `{"kubeconfig": "a3ViZWNvbmZpZw=="}`))
+
+	checks := 0
+	condition := func(context.Context, linodego.ClusterConditionOptions) (bool, error) {
+		checks++
+		return checks == 2, nil
+	}
+
+	ctx := linodego.WithPollOptions(waitTestContext(t, time.Second), linodego.PollOptions{
+		InitialInterval: time.Millisecond,
+	})
+
+	if err := client.WaitForLKEClusterConditions(ctx, 123, linodego.LKEClusterPollOptions{}, condition); err != nil {
+		t.Fatal(err)
+	}
+
+	if checks != 2 {
+		t.Fatalf("expected 2 checks, got %d", checks)
+	}
+
+	// The overall timeout of the wait applies to the conditions
+	ctx = linodego.WithPollOptions(context.Background(), linodego.PollOptions{
+		InitialInterval: time.Millisecond,
+		Timeout:         10 * time.Millisecond,
+	})
+
+	never := func(context.Context, linodego.ClusterConditionOptions) (bool, error) {
+		return false, nil
+	}
+
+	err := client.WaitForLKEClusterConditions(ctx, 123, linodego.LKEClusterPollOptions{}, never)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
 func waitTestContext(t *testing.T, timeout time.Duration) context.Context {
 	t.Helper()
 
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 	"net/url"
 )
 
@@ -69,8 +70,8 @@ func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]LinodeType), nil
+	if result := getCachedResponse[[]LinodeType](ctx, c, endpoint); result != nil {
+		return *result, nil
 	}
 
 	response, err := getPaginatedResults[LinodeType](ctx, c, e, opts)
@@ -78,18 +79,23 @@ func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
 
+// IterTypes returns an iterator over the results of ListTypes,
+// fetching pages lazily as they are consumed. Unlike ListTypes, results are not cached.
+func (c *Client) IterTypes(ctx context.Context, opts *ListOptions) iter.Seq2[LinodeType, error] {
+	return getPaginatedIter[LinodeType](ctx, c, "linode/types", opts)
+}
+
 // GetType gets the type with the provided ID. This endpoint is cached by default.
 func (c *Client) GetType(ctx context.Context, typeID string) (*LinodeType, error) {
 	e := formatAPIPath("linode/types/%s", url.PathEscape(typeID))
 
-	if result := c.getCachedResponse(e); result != nil {
-		result := result.(LinodeType)
-		return &result, nil
+	if result := getCachedResponse[LinodeType](ctx, c, e); result != nil {
+		return result, nil
 	}
 
 	response, err := doGETRequest[LinodeType](ctx, c, e)
@@ -97,7 +103,7 @@ func (c *Client) GetType(ctx context.Context, typeID string) (*LinodeType, error
 		return nil, err
 	}
 
-	c.addCachedResponse(e, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, e, response, &cacheExpiryTime)
 
 	return response, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -42,6 +43,12 @@ func (c *Client) ListVLANs(ctx context.Context, opts *ListOptions) ([]VLAN, erro
 	return getPaginatedResults[VLAN](ctx, c, "networking/vlans", opts)
 }
 
+// IterVLANs returns an iterator over the results of ListVLANs,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterVLANs(ctx context.Context, opts *ListOptions) iter.Seq2[VLAN, error] {
+	return getPaginatedIter[VLAN](ctx, c, "networking/vlans", opts)
+}
+
 // GetVLANIPAMAddress returns the IPAM Address for a given VLAN Label as a string (10.0.0.1/24)
 func (c *Client) GetVLANIPAMAddress(ctx context.Context, linodeID int, vlanLabel string) (string, error) {
 	f := Filter{}
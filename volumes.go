@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -131,6 +132,12 @@ func (c *Client) ListVolumes(ctx context.Context, opts *ListOptions) ([]Volume,
 	return getPaginatedResults[Volume](ctx, c, "volumes", opts)
 }
 
+// IterVolumes returns an iterator over the results of ListVolumes,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterVolumes(ctx context.Context, opts *ListOptions) iter.Seq2[Volume, error] {
+	return getPaginatedIter[Volume](ctx, c, "volumes", opts)
+}
+
 // GetVolume gets the template with the provided ID
 func (c *Client) GetVolume(ctx context.Context, volumeID int) (*Volume, error) {
 	e := formatAPIPath("volumes/%d", volumeID)
@@ -174,6 +181,14 @@ func (c *Client) ResizeVolume(ctx context.Context, volumeID int, opts VolumeResi
 	return doPOSTRequestNoResponseBody(ctx, c, e, opts)
 }
 
+// ResizeVolumeOp resizes a volume like ResizeVolume,
+// returning an Operation that tracks the resize.
+func (c *Client) ResizeVolumeOp(ctx context.Context, volumeID int, opts VolumeResizeOptions) (*Operation, error) {
+	return c.startOperation(ctx, EntityVolume, volumeID, ActionVolumeResize, func() error {
+		return c.ResizeVolume(ctx, volumeID, opts)
+	})
+}
+
 // DeleteVolume deletes the Volume with the specified id
 func (c *Client) DeleteVolume(ctx context.Context, volumeID int) error {
 	e := formatAPIPath("volumes/%d", volumeID)
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"iter"
 )
 
 // VolumeType represents a single valid Volume type.
@@ -30,8 +31,8 @@ func (c *Client) ListVolumeTypes(ctx context.Context, opts *ListOptions) ([]Volu
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]VolumeType), nil
+	if result := getCachedResponse[[]VolumeType](ctx, c, endpoint); result != nil {
+		return *result, nil
 	}
 
 	response, err := getPaginatedResults[VolumeType](ctx, c, e, opts)
@@ -39,7 +40,13 @@ func (c *Client) ListVolumeTypes(ctx context.Context, opts *ListOptions) ([]Volu
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
+
+// IterVolumeTypes returns an iterator over the results of ListVolumeTypes,
+// fetching pages lazily as they are consumed. Unlike ListVolumeTypes, results are not cached.
+func (c *Client) IterVolumeTypes(ctx context.Context, opts *ListOptions) iter.Seq2[VolumeType, error] {
+	return getPaginatedIter[VolumeType](ctx, c, "volumes/types", opts)
+}
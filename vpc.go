@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -180,6 +181,12 @@ func (c *Client) ListVPCs(ctx context.Context, opts *ListOptions) ([]VPC, error)
 	return getPaginatedResults[VPC](ctx, c, "vpcs", opts)
 }
 
+// IterVPCs returns an iterator over the results of ListVPCs,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterVPCs(ctx context.Context, opts *ListOptions) iter.Seq2[VPC, error] {
+	return getPaginatedIter[VPC](ctx, c, "vpcs", opts)
+}
+
 func (c *Client) UpdateVPC(
 	ctx context.Context,
 	vpcID int,
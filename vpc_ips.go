@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // ListAllVPCIPAddresses gets the list of all IP addresses of all VPCs in the Linode account.
@@ -12,6 +13,14 @@ func (c *Client) ListAllVPCIPAddresses(
 	return getPaginatedResults[VPCIP](ctx, c, "vpcs/ips", opts)
 }
 
+// IterAllVPCIPAddresses returns an iterator over the results of ListAllVPCIPAddresses,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterAllVPCIPAddresses(
+	ctx context.Context, opts *ListOptions,
+) iter.Seq2[VPCIP, error] {
+	return getPaginatedIter[VPCIP](ctx, c, "vpcs/ips", opts)
+}
+
 // ListVPCIPAddresses gets the list of all IP addresses of a specific VPC.
 func (c *Client) ListVPCIPAddresses(
 	ctx context.Context, vpcID int, opts *ListOptions,
@@ -19,6 +28,14 @@ func (c *Client) ListVPCIPAddresses(
 	return getPaginatedResults[VPCIP](ctx, c, fmt.Sprintf("vpcs/%d/ips", vpcID), opts)
 }
 
+// IterVPCIPAddresses returns an iterator over the results of ListVPCIPAddresses,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterVPCIPAddresses(
+	ctx context.Context, vpcID int, opts *ListOptions,
+) iter.Seq2[VPCIP, error] {
+	return getPaginatedIter[VPCIP](ctx, c, fmt.Sprintf("vpcs/%d/ips", vpcID), opts)
+}
+
 // ListAllVPCIPv6Addresses gets a list of all IPv6 addresses related to all VPCs
 // accessible by the current Linode account.
 // NOTE: IPv6 VPCs may not currently be available to all users.
@@ -28,6 +45,14 @@ func (c *Client) ListAllVPCIPv6Addresses(
 	return getPaginatedResults[VPCIP](ctx, c, "vpcs/ipv6s", opts)
 }
 
+// IterAllVPCIPv6Addresses returns an iterator over the results of ListAllVPCIPv6Addresses,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterAllVPCIPv6Addresses(
+	ctx context.Context, opts *ListOptions,
+) iter.Seq2[VPCIP, error] {
+	return getPaginatedIter[VPCIP](ctx, c, "vpcs/ipv6s", opts)
+}
+
 // ListVPCIPv6Addresses gets the list of all IPv6 addresses of a specific VPC.
 // NOTE: IPv6 VPCs may not currently be available to all users.
 func (c *Client) ListVPCIPv6Addresses(
@@ -35,3 +60,11 @@ func (c *Client) ListVPCIPv6Addresses(
 ) ([]VPCIP, error) {
 	return getPaginatedResults[VPCIP](ctx, c, fmt.Sprintf("vpcs/%d/ipv6s", vpcID), opts)
 }
+
+// IterVPCIPv6Addresses returns an iterator over the results of ListVPCIPv6Addresses,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterVPCIPv6Addresses(
+	ctx context.Context, vpcID int, opts *ListOptions,
+) iter.Seq2[VPCIP, error] {
+	return getPaginatedIter[VPCIP](ctx, c, fmt.Sprintf("vpcs/%d/ipv6s", vpcID), opts)
+}
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"time"
 
 	"github.com/linode/linodego/v2/internal/parseabletime"
@@ -142,6 +143,16 @@ func (c *Client) ListVPCSubnets(
 	return getPaginatedResults[VPCSubnet](ctx, c, formatAPIPath("vpcs/%d/subnets", vpcID), opts)
 }
 
+// IterVPCSubnets returns an iterator over the results of ListVPCSubnets,
+// fetching pages lazily as they are consumed.
+func (c *Client) IterVPCSubnets(
+	ctx context.Context,
+	vpcID int,
+	opts *ListOptions,
+) iter.Seq2[VPCSubnet, error] {
+	return getPaginatedIter[VPCSubnet](ctx, c, formatAPIPath("vpcs/%d/subnets", vpcID), opts)
+}
+
 func (c *Client) UpdateVPCSubnet(
 	ctx context.Context,
 	vpcID int,
@@ -42,8 +42,8 @@ func (client Client) WaitForInstanceStatus(ctx context.Context, instanceID int,
 
 			return instance, instance.Status == status, nil
 		},
-		func() error {
-			return fmt.Errorf("Error waiting for Instance %d status %s: %w", instanceID, status, ctx.Err())
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Instance %d status %s: %w", instanceID, status, err)
 		},
 	)
 }
@@ -71,12 +71,19 @@ func (client Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID i
 
 			return nil, false, nil
 		},
-		func() error {
-			return fmt.Errorf("Error waiting for Instance %d Disk %d status %s: %w", instanceID, diskID, status, ctx.Err())
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Instance %d Disk %d status %s: %w", instanceID, diskID, status, err)
 		},
 	)
 }
 
+// WaitForInstanceDiskClone waits for a disk clone of the Linode instance started at or after minStart
+// (see CloneInstanceDisk) to finish, returning its ActionDiskDuplicate event.
+// The progress of the clone is reported to the ProgressFunc of ctx, if any (see WithProgress).
+func (client Client) WaitForInstanceDiskClone(ctx context.Context, instanceID int, minStart time.Time) (*Event, error) {
+	return client.WaitForEventFinished(ctx, instanceID, EntityLinode, ActionDiskDuplicate, minStart)
+}
+
 // WaitForVolumeStatus waits for the Volume to reach the desired state
 // before returning.
 func (client Client) WaitForVolumeStatus(ctx context.Context, volumeID int, status VolumeStatus) (*Volume, error) {
@@ -89,8 +96,8 @@ func (client Client) WaitForVolumeStatus(ctx context.Context, volumeID int, stat
 
 			return volume, volume.Status == status, nil
 		},
-		func() error {
-			return fmt.Errorf("Error waiting for Volume %d status %s: %w", volumeID, status, ctx.Err())
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Volume %d status %s: %w", volumeID, status, err)
 		},
 	)
 }
@@ -112,8 +119,8 @@ func (client Client) WaitForSnapshotStatus(
 
 			return snapshot, snapshot.Status == status, nil
 		},
-		func() error {
-			return fmt.Errorf("Error waiting for Instance %d Snapshot %d status %s: %w", instanceID, snapshotID, status, ctx.Err())
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Instance %d Snapshot %d status %s: %w", instanceID, snapshotID, status, err)
 		},
 	)
 }
@@ -140,8 +147,8 @@ func (client Client) WaitForVolumeLinodeID(ctx context.Context, volumeID int, li
 
 			return volume, false, nil
 		},
-		func() error {
-			return fmt.Errorf("Error waiting for Volume %d to have Instance %v: %w", volumeID, linodeID, ctx.Err())
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Volume %d to have Instance %v: %w", volumeID, linodeID, err)
 		},
 	)
 }
@@ -158,8 +165,8 @@ func (client Client) WaitForLKEClusterStatus(ctx context.Context, clusterID int,
 
 			return cluster, cluster.Status == status, nil
 		},
-		func() error {
-			return fmt.Errorf("Error waiting for Cluster %d status %s: %w", clusterID, status, ctx.Err())
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Cluster %d status %s: %w", clusterID, status, err)
 		},
 	)
 }
@@ -196,41 +203,46 @@ func (client Client) WaitForLKEClusterConditions(
 		return fmt.Errorf("failed to get Kubeconfig for LKE cluster %d: %w", clusterID, err)
 	}
 
-	ticker := newTicker(&client)
-	defer ticker.Stop()
-
 	conditionOptions := ClusterConditionOptions{LKEClusterKubeconfig: lkeKubeConfig, TransportWrapper: options.TransportWrapper}
 
-	for _, condition := range conditions {
-	ConditionSucceeded:
-		for {
-			select {
-			case <-ticker.C:
-				result, err := condition(ctx, conditionOptions)
-				if err != nil {
-					log.Printf("[WARN] Ignoring WaitForLKEClusterConditions conditional error: %s", err)
-
-					if !options.Retry {
-						return err
-					}
-				}
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	// The conditions are checked in order, moving to the next one once the current one is reached
+	next := 0
 
-				if result {
-					break ConditionSucceeded
+	_, err = poll(ctx, &client,
+		func(ctx context.Context) (struct{}, bool, error) {
+			result, err := conditions[next](ctx, conditionOptions)
+			if err != nil {
+				if !options.Retry {
+					return struct{}{}, false, err
 				}
 
-			case <-ctx.Done():
-				return fmt.Errorf("Error waiting for cluster %d conditions: %w", clusterID, ctx.Err())
+				log.Printf("[WARN] Ignoring WaitForLKEClusterConditions conditional error: %s", err)
+
+				return struct{}{}, false, nil
 			}
-		}
-	}
 
-	return nil
+			if result {
+				next++
+			}
+
+			return struct{}{}, next == len(conditions), nil
+		},
+		func(err error) error {
+			return fmt.Errorf("Error waiting for cluster %d conditions: %w", clusterID, err)
+		},
+	)
+
+	return err
 }
 
 // WaitForEventFinished waits for an entity action to reach the 'finished' state
 // before returning.
 // If the event indicates a failure both the failed event and the error will be returned.
+// The progress of the event is reported to the ProgressFunc of ctx, if any (see WithProgress).
 // nolint
 func (client Client) WaitForEventFinished(
 	ctx context.Context,
@@ -269,31 +281,29 @@ func (client Client) WaitForEventFinished(
 		log.Printf("[INFO] Waiting %d seconds for %s events since %v for %s %v", int(time.Until(deadline).Seconds()), action, minStart, titledEntityType, id)
 	}
 
-	ticker := newTicker(&client)
+	progress := newProgressReporter(ctx)
 
 	// avoid repeating log messages
 	nextLog := ""
 	lastLog := ""
 	lastEventID := 0
 
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
+	return poll(ctx, &client,
+		func(ctx context.Context) (*Event, bool, error) {
 			if lastEventID > 0 {
 				filter.AddField(Gte, "id", lastEventID)
 			}
 
 			filterStr, err := filter.MarshalJSON()
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 
 			listOptions := NewListOptions(pages, string(filterStr))
 
 			events, err := client.ListEvents(ctx, listOptions)
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 
 			// If there are events for this instance + action, inspect them
@@ -339,12 +349,19 @@ func (client Client) WaitForEventFinished(
 					lastEventID = event.ID
 				}
 
+				// Other events for the entity action are not the one being tracked
+				if event.ID != lastEventID {
+					continue
+				}
+
+				progress.reportEvent(&event)
+
 				switch event.Status {
 				case EventFailed:
-					return &event, fmt.Errorf("%s %v action %s failed", titledEntityType, id, action)
+					return &event, false, fmt.Errorf("%s %v action %s failed", titledEntityType, id, action)
 				case EventFinished:
 					log.Printf("[INFO] %s %v action %s is finished", titledEntityType, id, action)
-					return &event, nil
+					return &event, true, nil
 				}
 
 				nextLog = fmt.Sprintf("[INFO] %s %v action %s is %s", titledEntityType, id, action, event.Status)
@@ -355,10 +372,13 @@ func (client Client) WaitForEventFinished(
 				log.Print(nextLog)
 				lastLog = nextLog
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Event Status '%s' of %s %v action '%s': %w", EventFinished, titledEntityType, id, action, ctx.Err())
-		}
-	}
+
+			return nil, false, nil
+		},
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Event Status '%s' of %s %v action '%s': %w", EventFinished, titledEntityType, id, action, err)
+		},
+	)
 }
 
 // WaitForImageStatus waits for the Image to reach the desired state
@@ -373,8 +393,8 @@ func (client Client) WaitForImageStatus(ctx context.Context, imageID string, sta
 
 			return image, image.Status == status, nil
 		},
-		func() error {
-			return fmt.Errorf("failed to wait for Image %s status %s: %w", imageID, status, ctx.Err())
+		func(err error) error {
+			return fmt.Errorf("failed to wait for Image %s status %s: %w", imageID, status, err)
 		},
 	)
 }
@@ -402,8 +422,8 @@ func (client Client) WaitForImageRegionStatus(ctx context.Context, imageID, regi
 
 			return image, true, nil
 		},
-		func() error {
-			return fmt.Errorf("failed to wait for Image %s status %s: %w", imageID, status, ctx.Err())
+		func(err error) error {
+			return fmt.Errorf("failed to wait for Image %s status %s: %w", imageID, status, err)
 		},
 	)
 }
@@ -447,8 +467,8 @@ func (client Client) WaitForDatabaseStatus(
 
 			return struct{}{}, currentStatus == status, nil
 		},
-		func() error {
-			return fmt.Errorf("failed to wait for database %d status: %w", dbID, ctx.Err())
+		func(err error) error {
+			return fmt.Errorf("failed to wait for database %d status: %w", dbID, err)
 		},
 	)
 
@@ -512,9 +532,6 @@ func (client Client) NewEventPollerWithoutEntity(entityType EntityType, action E
 
 // WaitForLatestUnknownEvent waits for the next event not observed by this poller.
 func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, error) {
-	ticker := newTicker(&p.client)
-	defer ticker.Stop()
-
 	f := Filter{
 		OrderBy: "created",
 		Order:   Descending,
@@ -533,12 +550,11 @@ func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, er
 		PageOptions: &PageOptions{Page: 1},
 	}
 
-	for {
-		select {
-		case <-ticker.C:
+	return poll(ctx, &p.client,
+		func(ctx context.Context) (*Event, bool, error) {
 			events, err := p.client.ListEvents(ctx, &listOpts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to list events: %w", err)
+				return nil, false, fmt.Errorf("failed to list events: %w", err)
 			}
 
 			for _, event := range events {
@@ -551,45 +567,53 @@ func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, er
 					// on subsequent jobs
 					p.previousEvents[event.ID] = true
 
-					return &event, nil
+					return &event, true, nil
 				}
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to wait for event: %w", ctx.Err())
-		}
-	}
+
+			return nil, false, nil
+		},
+		func(err error) error {
+			return fmt.Errorf("failed to wait for event: %w", err)
+		},
+	)
 }
 
 // WaitForFinished waits for a new event to be finished.
+// The progress of the event is reported to the ProgressFunc of ctx, if any (see WithProgress).
 func (p *EventPoller) WaitForFinished(ctx context.Context) (*Event, error) {
-	ticker := newTicker(&p.client)
-	defer ticker.Stop()
+	// The timeout of the PollOptions of ctx applies to the whole wait
+	ctx, cancel := withPollTimeout(ctx, &p.client)
+	defer cancel()
+
+	progress := newProgressReporter(ctx)
 
 	event, err := p.WaitForLatestUnknownEvent(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to wait for event: %w", err)
 	}
 
-	for {
-		select {
-		case <-ticker.C:
-			event, err = p.client.GetEvent(ctx, event.ID)
+	progress.reportEvent(event)
+
+	return poll(ctx, &p.client,
+		func(ctx context.Context) (*Event, bool, error) {
+			current, err := p.client.GetEvent(ctx, event.ID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get event: %w", err)
+				return nil, false, fmt.Errorf("failed to get event: %w", err)
 			}
 
-			switch event.Status {
-			case EventFinished:
-				return event, nil
-			case EventFailed:
-				return nil, fmt.Errorf("event %d has failed", event.ID)
-			case EventScheduled, EventStarted, EventNotification:
-				continue
+			progress.reportEvent(current)
+
+			if current.Status == EventFailed {
+				return nil, false, fmt.Errorf("event %d has failed", current.ID)
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to wait for event finished: %w", ctx.Err())
-		}
-	}
+
+			return current, current.Status == EventFinished, nil
+		},
+		func(err error) error {
+			return fmt.Errorf("failed to wait for event finished: %w", err)
+		},
+	)
 }
 
 // WaitForResourceFree waits for a resource to have no running events.
@@ -608,9 +632,6 @@ func (client Client) WaitForResourceFree(
 		return fmt.Errorf("failed to create filter: %s", err)
 	}
 
-	ticker := newTicker(&client)
-	defer ticker.Stop()
-
 	// A helper function to determine whether a resource is busy
 	checkIsBusy := func(events []Event) bool {
 		for _, event := range events {
@@ -622,24 +643,23 @@ func (client Client) WaitForResourceFree(
 		return false
 	}
 
-	for {
-		select {
-		case <-ticker.C:
+	_, err = poll(ctx, &client,
+		func(ctx context.Context) (struct{}, bool, error) {
 			events, err := client.ListEvents(ctx, &ListOptions{
 				Filter: string(filterStr),
 			})
 			if err != nil {
-				return fmt.Errorf("failed to list events: %s", err)
+				return struct{}{}, false, fmt.Errorf("failed to list events: %w", err)
 			}
 
-			if !checkIsBusy(events) {
-				return nil
-			}
+			return struct{}{}, !checkIsBusy(events), nil
+		},
+		func(err error) error {
+			return fmt.Errorf("failed to wait for resource free: %w", err)
+		},
+	)
 
-		case <-ctx.Done():
-			return fmt.Errorf("failed to wait for resource free: %s", ctx.Err())
-		}
-	}
+	return err
 }
 
 // eventMatchesSecondary returns whether the given event's secondary entity
@@ -678,8 +698,8 @@ func (client Client) WaitForAlertDefinitionStatus(
 
 			return alertDef, alertDef.Status == status, nil
 		},
-		func() error {
-			return fmt.Errorf("failed to wait for AlertDefinition %d status %s: %w", alertID, status, ctx.Err())
+		func(err error) error {
+			return fmt.Errorf("failed to wait for AlertDefinition %d status %s: %w", alertID, status, err)
 		},
 	)
 }
@@ -700,8 +720,8 @@ func (client Client) WaitForVolumeIOReadyStatus(
 
 			return volume, volume.IOReady == status, nil
 		},
-		func() error {
-			return fmt.Errorf("failed to wait for Volume %d IO Ready status %t: %w", volumeID, status, ctx.Err())
+		func(err error) error {
+			return fmt.Errorf("failed to wait for Volume %d IO Ready status %t: %w", volumeID, status, err)
 		},
 	)
 }
@@ -740,32 +760,54 @@ func (p *EventPoller) preTask(ctx context.Context) error {
 	return nil
 }
 
-// poll runs check on each tick until check reports done, returns an error, or ctx is canceled.
+// poll runs check after each poll interval until check reports done, returns an error, or ctx is canceled.
+// The intervals, transient error tolerance and timeout are configured by the PollOptions of ctx.
 //
 //nolint:ireturn // false positive: returning a generic concrete type, not an interface
 func poll[T any](
 	ctx context.Context,
 	client *Client,
 	check func(context.Context) (T, bool, error),
-	timeoutErr func() error,
+	timeoutErr func(error) error,
 ) (T, error) {
-	ticker := newTicker(client)
-	defer ticker.Stop()
+	options := pollOptionsFromContext(ctx, client)
+
+	ctx, cancel := withPollTimeout(ctx, client)
+	defer cancel()
 
-	for {
+	intervals := pollIntervals{options: options}
+	timer := time.NewTimer(intervals.next())
+
+	defer timer.Stop()
+
+	start := time.Now()
+	transientErrors := 0
+
+	for attempt := 1; ; attempt++ {
 		select {
-		case <-ticker.C:
+		case <-timer.C:
 			result, done, err := check(ctx)
-			if err != nil {
-				return result, err
+
+			if options.OnPoll != nil {
+				options.OnPoll(PollAttempt{Attempt: attempt, Elapsed: time.Since(start), Done: done && err == nil, Err: err})
 			}
 
-			if done {
+			if err != nil {
+				if ctx.Err() != nil || transientErrors >= options.MaxTransientErrors || !isTransientError(err) {
+					return result, err
+				}
+
+				transientErrors++
+				log.Printf("[WARN] Ignoring transient error while polling (%d of %d): %s",
+					transientErrors, options.MaxTransientErrors, err)
+			} else if done {
 				return result, nil
 			}
+
+			timer.Reset(intervals.next())
 		case <-ctx.Done():
 			var zero T
-			return zero, timeoutErr()
+			return zero, timeoutErr(ctx.Err())
 		}
 	}
 }
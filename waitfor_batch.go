@@ -0,0 +1,396 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EventWaitTarget identifies an entity action waited for by a BatchEventWaiter.
+type EventWaitTarget struct {
+	EntityType EntityType
+	EntityID   any
+	Action     EventAction
+
+	// MinStart is the earliest time the event may have been created.
+	// Defaults to the time the target is added to the waiter.
+	MinStart time.Time
+
+	// Timeout is how long to wait for the event to finish.
+	// Defaults to the Timeout of the waiter.
+	Timeout time.Duration
+}
+
+func (t EventWaitTarget) String() string {
+	return fmt.Sprintf("%s %v action %s", t.EntityType, t.EntityID, t.Action)
+}
+
+// BatchEventWaiterOptions configures a BatchEventWaiter.
+type BatchEventWaiterOptions struct {
+	// Timeout is how long to wait for each target to finish.
+	// If 0, targets are waited for until the context passed to Run is canceled.
+	Timeout time.Duration
+}
+
+// BatchEventWaiter waits for the events of many entity actions to finish, such as the boots of
+// many instances, using a single ListEvents query per poll rather than one query per entity.
+//
+//	waiter := client.NewBatchEventWaiter(linodego.BatchEventWaiterOptions{Timeout: 10 * time.Minute})
+//	for _, instance := range instances {
+//		waiter.Add(linodego.EventWaitTarget{EntityType: linodego.EntityLinode, EntityID: instance.ID, Action: linodego.ActionLinodeBoot})
+//	}
+//	err := waiter.Run(ctx)
+type BatchEventWaiter struct {
+	client  Client
+	options BatchEventWaiterOptions
+
+	mu      sync.Mutex
+	futures []*EventFuture
+}
+
+// EventFuture is the result of waiting for the event of an EventWaitTarget.
+type EventFuture struct {
+	target   EventWaitTarget
+	deadline time.Time
+
+	done  chan struct{}
+	event *Event
+	err   error
+}
+
+// NewBatchEventWaiter creates a BatchEventWaiter.
+func (c *Client) NewBatchEventWaiter(opts BatchEventWaiterOptions) *BatchEventWaiter {
+	return &BatchEventWaiter{
+		client:  *c,
+		options: opts,
+	}
+}
+
+// Add adds a target to wait for. Targets may be added while Run is running.
+func (w *BatchEventWaiter) Add(target EventWaitTarget) *EventFuture {
+	now := time.Now()
+
+	if target.MinStart.IsZero() {
+		target.MinStart = now
+	}
+
+	if target.Timeout == 0 {
+		target.Timeout = w.options.Timeout
+	}
+
+	future := &EventFuture{
+		target: target,
+		done:   make(chan struct{}),
+	}
+
+	if target.Timeout > 0 {
+		future.deadline = now.Add(target.Timeout)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.futures = append(w.futures, future)
+
+	return future
+}
+
+// Run polls for the events of the added targets until all of them are resolved, resolving each
+// future as soon as its event finishes or fails, or its timeout expires.
+// If any target did not finish, a *BatchEventWaitError reporting each failure is returned.
+// Transient errors (e.g. 5xx responses and timeouts) are logged and the events are polled again,
+// while any other error fails all pending targets.
+// Run must not be called concurrently.
+func (w *BatchEventWaiter) Run(ctx context.Context) error {
+	ticker := newTicker(&w.client)
+	defer ticker.Stop()
+
+	for {
+		pending := w.pending()
+		if len(pending) == 0 {
+			return w.err()
+		}
+
+		select {
+		case <-ticker.C:
+			if err := w.poll(ctx, pending); err != nil {
+				// Errors that are unlikely to succeed when polled again (e.g. invalid credentials)
+				// fail all pending targets, rather than polling until they time out
+				if ctx.Err() == nil && !isTransientError(err) {
+					for _, future := range pending {
+						future.resolve(nil, fmt.Errorf("failed to poll events for %s: %w", future.target, err))
+					}
+
+					continue
+				}
+
+				log.Printf("[WARN] Failed to poll events: %s", err)
+			}
+
+			now := time.Now()
+
+			for _, future := range pending {
+				if !future.deadline.IsZero() && now.After(future.deadline) {
+					future.resolve(nil, fmt.Errorf(
+						"timed out waiting for %s to finish after %s: %w",
+						future.target, future.target.Timeout, context.DeadlineExceeded,
+					))
+				}
+			}
+		case <-ctx.Done():
+			for _, future := range pending {
+				future.resolve(nil, fmt.Errorf("failed to wait for %s: %w", future.target, ctx.Err()))
+			}
+		}
+	}
+}
+
+// pending returns the futures that have not been resolved.
+func (w *BatchEventWaiter) pending() []*EventFuture {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var pending []*EventFuture
+
+	for _, future := range w.futures {
+		if !future.resolved() {
+			pending = append(pending, future)
+		}
+	}
+
+	return pending
+}
+
+// err returns the aggregate error of the resolved futures, if any failed.
+func (w *BatchEventWaiter) err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var failures []EventWaitFailure
+
+	for _, future := range w.futures {
+		if future.err != nil {
+			failures = append(failures, EventWaitFailure{Target: future.target, Event: future.event, Err: future.err})
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	return &BatchEventWaitError{Failures: failures, Total: len(w.futures)}
+}
+
+// maxEventFilterTargets is the maximum number of targets (or event IDs) polled using a single query,
+// which keeps the X-Filter header of each query small.
+const maxEventFilterTargets = 50
+
+// poll lists the events of the pending futures using a combined filter per chunk of targets,
+// resolving the futures whose events have finished or failed.
+func (w *BatchEventWaiter) poll(ctx context.Context, pending []*EventFuture) error {
+	for chunk := range slices.Chunk(pending, maxEventFilterTargets) {
+		if err := w.pollChunk(ctx, chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pollChunk lists the events of the given futures using a single filter,
+// which matches the entity IDs of the targets grouped by action and entity type.
+func (w *BatchEventWaiter) pollChunk(ctx context.Context, pending []*EventFuture) error {
+	type targetGroup struct {
+		action     EventAction
+		entityType EntityType
+	}
+
+	var groups []targetGroup
+
+	entityIDs := make(map[targetGroup][]FilterNode)
+	seen := make(map[string]bool)
+	minStart := pending[0].target.MinStart
+
+	for _, future := range pending {
+		target := future.target
+
+		if target.MinStart.Before(minStart) {
+			minStart = target.MinStart
+		}
+
+		group := targetGroup{action: target.Action}
+
+		// The API only supports filtering on the entity of some entity types,
+		// the rest are matched after listing the events
+		id, err := strconv.Atoi(eventEntityIDString(target.EntityID))
+		if isEventEntityFilterable(target.EntityType) && err == nil {
+			group.entityType = target.EntityType
+		}
+
+		if _, ok := entityIDs[group]; !ok {
+			groups = append(groups, group)
+			entityIDs[group] = nil
+		}
+
+		if key := fmt.Sprintf("%v %d", group, id); group.entityType != "" && !seen[key] {
+			seen[key] = true
+			entityIDs[group] = append(entityIDs[group], FieldEq("entity.id", id))
+		}
+	}
+
+	targets := make([]FilterNode, 0, len(groups))
+
+	for _, group := range groups {
+		if group.entityType == "" {
+			targets = append(targets, FieldEq("action", group.action))
+			continue
+		}
+
+		targets = append(targets, And("", "",
+			FieldEq("action", group.action),
+			FieldEq("entity.type", group.entityType),
+			Or("", "", entityIDs[group]...),
+		))
+	}
+
+	filter := Filter{
+		Order:   Descending,
+		OrderBy: "created",
+	}
+	filter.AddField(Gte, "created", minStart.UTC().Format("2006-01-02T15:04:05"))
+	filter.AddNode(Or("", "", targets...))
+
+	filterStr, err := filter.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	events, err := w.client.ListEvents(ctx, &ListOptions{Filter: string(filterStr)})
+	if err != nil {
+		return err
+	}
+
+	for _, future := range pending {
+		// Events are ordered by creation, so the first match is the latest event for the target
+		for _, event := range events {
+			if !future.target.matches(event) {
+				continue
+			}
+
+			switch event.Status {
+			case EventFinished:
+				future.resolve(&event, nil)
+			case EventFailed:
+				future.resolve(&event, fmt.Errorf("%s failed", future.target))
+			}
+
+			break
+		}
+	}
+
+	return nil
+}
+
+// matches returns whether the event belongs to the target.
+func (t EventWaitTarget) matches(event Event) bool {
+	if event.Action != t.Action || event.Entity == nil || event.Entity.Type != t.EntityType {
+		return false
+	}
+
+	if event.Created != nil && event.Created.Before(t.MinStart.Truncate(time.Second)) {
+		return false
+	}
+
+	return eventEntityIDString(event.Entity.ID) == eventEntityIDString(t.EntityID)
+}
+
+// Target returns the target of the future.
+func (f *EventFuture) Target() EventWaitTarget {
+	return f.target
+}
+
+// Done returns a channel that is closed once the future is resolved.
+func (f *EventFuture) Done() <-chan struct{} {
+	return f.done
+}
+
+// Wait waits for the future to be resolved, returning the finished event.
+// If the event failed, both the failed event and an error are returned.
+func (f *EventFuture) Wait(ctx context.Context) (*Event, error) {
+	select {
+	case <-f.done:
+		return f.event, f.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to wait for %s: %w", f.target, ctx.Err())
+	}
+}
+
+func (f *EventFuture) resolve(event *Event, err error) {
+	if f.resolved() {
+		return
+	}
+
+	f.event = event
+	f.err = err
+	close(f.done)
+}
+
+func (f *EventFuture) resolved() bool {
+	select {
+	case <-f.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// EventWaitFailure is a target of a BatchEventWaiter that did not finish.
+type EventWaitFailure struct {
+	Target EventWaitTarget
+
+	// Event is the failed event, or nil if the target timed out.
+	Event *Event
+
+	Err error
+}
+
+// BatchEventWaitError reports the targets of a BatchEventWaiter that did not finish.
+type BatchEventWaitError struct {
+	Failures []EventWaitFailure
+
+	// Total is the number of targets waited for.
+	Total int
+}
+
+func (e *BatchEventWaitError) Error() string {
+	messages := make([]string, len(e.Failures))
+	for i, failure := range e.Failures {
+		messages[i] = failure.Err.Error()
+	}
+
+	return fmt.Sprintf("%d of %d event waits failed: %s", len(e.Failures), e.Total, strings.Join(messages, "; "))
+}
+
+func (e *BatchEventWaitError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, failure := range e.Failures {
+		errs[i] = failure.Err
+	}
+
+	return errs
+}
+
+// isEventEntityFilterable returns whether events can be filtered by the ID of entities of the given type.
+func isEventEntityFilterable(entityType EntityType) bool {
+	switch entityType {
+	case EntityDisk, EntityDatabase, EntityLinode, EntityDomain, EntityNodebalancer:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,186 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// batchFilterEntityIDs returns the entity IDs matched by each target group of a BatchEventWaiter filter.
+func batchFilterEntityIDs(t *testing.T, r *http.Request) [][]int {
+	t.Helper()
+
+	var filter struct {
+		Or []struct {
+			And []struct {
+				Or []struct {
+					EntityID int `json:"entity.id"`
+				} `json:"+or"`
+			} `json:"+and"`
+		} `json:"+or"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(r.Header.Get("X-Filter")), &filter))
+
+	groups := make([][]int, 0, len(filter.Or))
+
+	for _, group := range filter.Or {
+		var ids []int
+
+		for _, node := range group.And {
+			for _, id := range node.Or {
+				ids = append(ids, id.EntityID)
+			}
+		}
+
+		groups = append(groups, ids)
+	}
+
+	return groups
+}
+
+func TestBatchEventWaiter(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// All pending targets are polled using a single query, grouping the entity IDs of each action
+		if requests.Add(1) == 1 {
+			require.Equal(t, [][]int{{1, 2, 3}}, batchFilterEntityIDs(t, r))
+		} else {
+			require.Equal(t, [][]int{{3}}, batchFilterEntityIDs(t, r))
+		}
+
+		created := time.Now().UTC().Format("2006-01-02T15:04:05")
+		event := func(id, entityID int, status EventStatus) map[string]any {
+			return map[string]any{
+				"id":      id,
+				"action":  ActionLinodeBoot,
+				"status":  status,
+				"created": created,
+				"entity":  map[string]any{"id": entityID, "type": EntityLinode},
+			}
+		}
+
+		body, _ := json.Marshal(map[string]any{
+			"data": []map[string]any{
+				event(4, 1, EventFinished),
+				event(3, 2, EventFailed),
+				event(2, 3, EventStarted),
+				event(1, 1, EventStarted),
+			},
+			"page":    1,
+			"pages":   1,
+			"results": 4,
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(10 * time.Millisecond)
+
+	waiter := client.NewBatchEventWaiter(BatchEventWaiterOptions{Timeout: time.Minute})
+
+	finished := waiter.Add(EventWaitTarget{EntityType: EntityLinode, EntityID: 1, Action: ActionLinodeBoot})
+	failed := waiter.Add(EventWaitTarget{EntityType: EntityLinode, EntityID: 2, Action: ActionLinodeBoot})
+	timedOut := waiter.Add(EventWaitTarget{
+		EntityType: EntityLinode, EntityID: 3, Action: ActionLinodeBoot, Timeout: 100 * time.Millisecond,
+	})
+
+	err := waiter.Run(context.Background())
+
+	var batchErr *BatchEventWaitError
+	require.ErrorAs(t, err, &batchErr)
+	require.Equal(t, 3, batchErr.Total)
+	require.Len(t, batchErr.Failures, 2)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	event, err := finished.Wait(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 4, event.ID)
+
+	event, err = failed.Wait(context.Background())
+	require.Error(t, err)
+	require.Equal(t, 3, event.ID)
+	require.Equal(t, failed.Target(), batchErr.Failures[0].Target)
+
+	event, err = timedOut.Wait(context.Background())
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.Nil(t, event)
+}
+
+func TestBatchEventWaiter_ChunksTargets(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		chunks []int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		groups := batchFilterEntityIDs(t, r)
+		require.Len(t, groups, 1)
+
+		mu.Lock()
+		chunks = append(chunks, len(groups[0]))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(10 * time.Millisecond)
+
+	waiter := client.NewBatchEventWaiter(BatchEventWaiterOptions{Timeout: 15 * time.Millisecond})
+
+	for id := range 120 {
+		waiter.Add(EventWaitTarget{EntityType: EntityLinode, EntityID: id, Action: ActionLinodeBoot})
+	}
+
+	require.ErrorIs(t, waiter.Run(context.Background()), context.DeadlineExceeded)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	require.Equal(t, []int{50, 50, 20}, chunks[:3])
+}
+
+func TestBatchEventWaiter_FailsOnNonTransientError(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errors": [{"reason": "Invalid Token"}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil)
+	client.SetBaseURL(server.URL).SetPollDelay(10 * time.Millisecond)
+
+	// Without a timeout, the waiter would poll forever if the error was ignored
+	waiter := client.NewBatchEventWaiter(BatchEventWaiterOptions{})
+	future := waiter.Add(EventWaitTarget{EntityType: EntityLinode, EntityID: 1, Action: ActionLinodeBoot})
+
+	err := waiter.Run(context.Background())
+
+	var batchErr *BatchEventWaitError
+	require.ErrorAs(t, err, &batchErr)
+	require.Len(t, batchErr.Failures, 1)
+	require.True(t, ErrHasStatus(err, http.StatusUnauthorized))
+	require.Equal(t, int32(1), requests.Load())
+
+	_, err = future.Wait(context.Background())
+	require.Error(t, err)
+}
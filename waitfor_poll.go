@@ -0,0 +1,132 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+)
+
+// PollOptions configures how the WaitFor* helpers, EventPoller and Operation poll the API.
+// See WithPollOptions.
+type PollOptions struct {
+	// InitialInterval is the delay before the first poll.
+	// Defaults to the client's poll delay (see SetPollDelay).
+	InitialInterval time.Duration
+
+	// MaxInterval is the maximum delay between polls.
+	// The delay grows by Multiplier after each poll until it reaches MaxInterval.
+	// If MaxInterval is not greater than InitialInterval, the delay does not grow.
+	MaxInterval time.Duration
+
+	// Multiplier is the factor the delay grows by after each poll. Defaults to 2.
+	Multiplier float64
+
+	// Jitter randomizes each delay by up to the given fraction of it, e.g. 0.2 for ±20%,
+	// to avoid many waiters polling in lockstep.
+	Jitter float64
+
+	// MaxTransientErrors is the number of transient errors (e.g. 5xx responses and timeouts)
+	// tolerated during the wait. By default, any error aborts the wait.
+	MaxTransientErrors int
+
+	// Timeout limits the overall duration of the wait.
+	Timeout time.Duration
+
+	// OnPoll is called after each poll.
+	OnPoll func(PollAttempt)
+}
+
+// PollAttempt describes a poll made by a WaitFor* helper.
+type PollAttempt struct {
+	// Attempt is the number of the poll, starting at 1.
+	Attempt int
+
+	// Elapsed is the time since the wait started.
+	Elapsed time.Duration
+
+	// Done is whether the awaited condition was reached.
+	Done bool
+
+	// Err is the error of the poll, if any.
+	Err error
+}
+
+type pollOptionsContextKey struct{}
+
+// WithPollOptions returns a copy of ctx that configures how WaitFor* helpers called with it poll the API.
+//
+//	ctx = linodego.WithPollOptions(ctx, linodego.PollOptions{
+//		InitialInterval:    time.Second,
+//		MaxInterval:        30 * time.Second,
+//		Jitter:             0.2,
+//		MaxTransientErrors: 5,
+//		Timeout:            20 * time.Minute,
+//	})
+//	instance, err := client.WaitForInstanceStatus(ctx, instanceID, linodego.InstanceRunning)
+func WithPollOptions(ctx context.Context, opts PollOptions) context.Context {
+	return context.WithValue(ctx, pollOptionsContextKey{}, opts)
+}
+
+// pollOptionsFromContext returns the PollOptions of ctx, with defaults applied using the client.
+func pollOptionsFromContext(ctx context.Context, client *Client) PollOptions {
+	opts, _ := ctx.Value(pollOptionsContextKey{}).(PollOptions)
+
+	if opts.InitialInterval <= 0 {
+		opts.InitialInterval = client.GetPollDelay()
+	}
+
+	if opts.Multiplier <= 1 {
+		opts.Multiplier = 2
+	}
+
+	return opts
+}
+
+// withPollTimeout returns a copy of ctx limited by the Timeout of its PollOptions, if any.
+func withPollTimeout(ctx context.Context, client *Client) (context.Context, context.CancelFunc) {
+	if timeout := pollOptionsFromContext(ctx, client).Timeout; timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+
+	return context.WithCancel(ctx)
+}
+
+// pollIntervals returns the delays between polls.
+type pollIntervals struct {
+	options PollOptions
+	current time.Duration
+}
+
+func (p *pollIntervals) next() time.Duration {
+	if p.current == 0 {
+		p.current = p.options.InitialInterval
+	} else if p.options.MaxInterval > p.current {
+		p.current = min(time.Duration(float64(p.current)*p.options.Multiplier), p.options.MaxInterval)
+	}
+
+	interval := p.current
+
+	if p.options.Jitter > 0 && interval > 0 {
+		jitter := time.Duration(float64(interval) * min(p.options.Jitter, 1))
+		interval += rand.N(2*jitter+1) - jitter //nolint:gosec // jitter does not need a secure source
+	}
+
+	return interval
+}
+
+// isTransientError returns whether err is likely to succeed if the request is repeated.
+func isTransientError(err error) bool {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == http.StatusRequestTimeout ||
+			apiErr.Code == http.StatusTooManyRequests ||
+			apiErr.Code >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}